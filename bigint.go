@@ -0,0 +1,148 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// ToBigInt returns the bits as an unsigned integer, bit i has the value 2^i.
+func (s *BitVector) ToBigInt() *big.Int {
+	arrayLength := s.words()
+
+	b := make([]byte, 0, arrayLength*4)
+	for i := arrayLength - 1; i >= 0; i-- {
+		word := s.maskedWord(i)
+		b = append(b, byte(word>>24), byte(word>>16), byte(word>>8), byte(word))
+	}
+	return new(big.Int).SetBytes(b)
+}
+
+// FromBigInt allocates a BitVector of length bits holding the unsigned integer x.
+func FromBigInt(x *big.Int, length int) (*BitVector, error) {
+	if x == nil {
+		return nil, ErrNilVector
+	}
+	if length < 0 {
+		return nil, ErrNegativeLength
+	}
+	if x.Sign() < 0 {
+		return nil, fmt.Errorf("%w: x must not be negative", ErrIndexOutOfRange)
+	}
+	if x.BitLen() > length {
+		return nil, fmt.Errorf("%w: x needs %v bits", ErrLengthMismatch, x.BitLen())
+	}
+
+	vector := NewBitVector(length)
+	b := x.Bytes()
+	for i := range b {
+		index := len(b) - 1 - i
+		vector.array[index/4] |= uint32(b[i]) << (8 * (index % 4))
+	}
+	return vector, nil
+}
+
+// Add sets s to s + vector modulo 2^length, treating both as unsigned integers,
+// and returns the carry out of the top bit. Both must have the same length.
+func (s *BitVector) Add(vector *BitVector) bool {
+	arrayLength := s.relation(vector)
+
+	carry := uint32(0)
+	for i := 0; i < arrayLength; i++ {
+		s.array[i], carry = addWord(s.maskedWord(i), vector.maskedWord(i), carry, s.length-i*bitsPerInt32)
+	}
+
+	s.version++
+	return carry == 1
+}
+
+// Sub sets s to s - vector modulo 2^length, treating both as unsigned integers,
+// and returns the borrow out of the top bit. Both must have the same length.
+func (s *BitVector) Sub(vector *BitVector) bool {
+	arrayLength := s.relation(vector)
+
+	borrow := uint32(0)
+	for i := 0; i < arrayLength; i++ {
+		x, y := s.maskedWord(i), vector.maskedWord(i)
+
+		rest := s.length - i*bitsPerInt32
+		if rest >= bitsPerInt32 {
+			s.array[i], borrow = bits.Sub32(x, y, borrow)
+			continue
+		}
+
+		diff := x - y - borrow
+		s.array[i] = diff & (1<<rest - 1)
+		borrow = (diff >> rest) & 1
+	}
+
+	s.version++
+	return borrow == 1
+}
+
+// Increment adds one to s modulo 2^length and returns the carry out of the top bit.
+func (s *BitVector) Increment() bool {
+	arrayLength := s.words()
+
+	carry := uint32(1)
+	for i := 0; i < arrayLength && carry == 1; i++ {
+		s.array[i], carry = addWord(s.maskedWord(i), 0, carry, s.length-i*bitsPerInt32)
+	}
+
+	s.version++
+	return carry == 1
+}
+
+// CompareUint compares s and vector as unsigned integers and returns -1, 0 or +1.
+// Unlike Compare the lengths may differ and only the values matter.
+func (s *BitVector) CompareUint(vector *BitVector) int {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+
+	left, right := s.words(), vector.words()
+	for i := maxInt(left, right) - 1; i >= 0; i-- {
+		x, y := uint32(0), uint32(0)
+		if i < left {
+			x = s.maskedWord(i)
+		}
+		if i < right {
+			y = vector.maskedWord(i)
+		}
+
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+// LeadingZeros returns the number of false bits above the highest true bit,
+// counting down from index length-1. It is the length when every bit is false.
+func (s *BitVector) LeadingZeros() int {
+	zeros := 0
+	for i := s.words() - 1; i >= 0; i-- {
+		rest := minInt(bitsPerInt32, s.length-i*bitsPerInt32)
+
+		word := s.maskedWord(i)
+		if word != 0 {
+			return zeros + rest - bits.Len32(word)
+		}
+		zeros += rest
+	}
+	return zeros
+}
+
+// addWord adds x, y and carry, which fit in the low rest bits, and returns the
+// low rest bits of the sum and the carry out of them.
+func addWord(x, y, carry uint32, rest int) (uint32, uint32) {
+	if rest >= bitsPerInt32 {
+		return bits.Add32(x, y, carry)
+	}
+
+	sum := x + y + carry
+	return sum & (1<<rest - 1), sum >> rest
+}
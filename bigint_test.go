@@ -0,0 +1,97 @@
+package bitvector_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitVector_BigInt(t *testing.T) {
+	s := bitvector.NewBitVectorFromBool([]bool{true, false, true, true})
+	if got := s.ToBigInt(); got.Int64() != 13 {
+		t.Errorf("BitVector.ToBigInt() = %v, want %v", got, 13)
+	}
+
+	full := bitvector.NewBitVectorOfLength(40, true)
+	want := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 40), big.NewInt(1))
+	if got := full.ToBigInt(); got.Cmp(want) != 0 {
+		t.Errorf("BitVector.ToBigInt() = %v, want %v", got, want)
+	}
+
+	back, err := bitvector.FromBigInt(want, 45)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Length() != 45 || back.ToBigInt().Cmp(want) != 0 {
+		t.Errorf("FromBigInt() = %v, want %v", back.ToBigInt(), want)
+	}
+
+	if _, err := bitvector.FromBigInt(want, 39); err == nil {
+		t.Errorf("FromBigInt() err = nil, want error")
+	}
+}
+
+func TestBitVector_Arithmetic(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, length := range []int{1, 5, 31, 32, 33, 64, 65, 100} {
+		modulus := new(big.Int).Lsh(big.NewInt(1), uint(length))
+		for i := 0; i < 200; i++ {
+			x := new(big.Int).Rand(r, modulus)
+			y := new(big.Int).Rand(r, modulus)
+			if i == 0 {
+				x.Sub(modulus, big.NewInt(1))
+				y.SetInt64(1)
+			}
+
+			a, _ := bitvector.FromBigInt(x, length)
+			b, _ := bitvector.FromBigInt(y, length)
+
+			sum := bitvector.NewBitVectorFromVector(*a)
+			carry := sum.Add(b)
+			want := new(big.Int).Add(x, y)
+			if carry != (want.Cmp(modulus) >= 0) || sum.ToBigInt().Cmp(want.Mod(want, modulus)) != 0 {
+				t.Fatalf("%v + %v = %v carry %v, want %v", x, y, sum.ToBigInt(), carry, want)
+			}
+
+			diff := bitvector.NewBitVectorFromVector(*a)
+			borrow := diff.Sub(b)
+			want = new(big.Int).Sub(x, y)
+			if borrow != (want.Sign() < 0) || diff.ToBigInt().Cmp(want.Mod(want, modulus)) != 0 {
+				t.Fatalf("%v - %v = %v borrow %v, want %v", x, y, diff.ToBigInt(), borrow, want)
+			}
+
+			incremented := bitvector.NewBitVectorFromVector(*a)
+			carry = incremented.Increment()
+			want = new(big.Int).Add(x, big.NewInt(1))
+			if carry != (want.Cmp(modulus) == 0) || incremented.ToBigInt().Cmp(want.Mod(want, modulus)) != 0 {
+				t.Fatalf("%v + 1 = %v carry %v, want %v", x, incremented.ToBigInt(), carry, want)
+			}
+
+			if got := a.CompareUint(b); got != x.Cmp(y) {
+				t.Fatalf("CompareUint(%v, %v) = %v, want %v", x, y, got, x.Cmp(y))
+			}
+
+			if got := a.LeadingZeros(); got != length-x.BitLen() {
+				t.Fatalf("LeadingZeros(%v) = %v, want %v", x, got, length-x.BitLen())
+			}
+		}
+	}
+}
+
+func TestBitVector_Arithmetic_IgnoresBitsPastLength(t *testing.T) {
+	s := bitvector.NewBitVectorOfLength(5, true)
+	if !s.Increment() {
+		t.Errorf("BitVector.Increment() carry = false, want true")
+	}
+	if !s.IsEmpty() || s.LeadingZeros() != 5 {
+		t.Errorf("BitVector.Increment() = %v, want 0", s)
+	}
+
+	short := bitvector.NewBitVectorFromBool([]bool{true})
+	long := bitvector.NewBitVectorFromBool([]bool{true, false, false})
+	if got := short.CompareUint(long); got != 0 {
+		t.Errorf("BitVector.CompareUint() = %v, want %v", got, 0)
+	}
+}
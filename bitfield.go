@@ -0,0 +1,101 @@
+package bitvector
+
+import "fmt"
+
+// Returns the width bit field starting at offset as an unsigned integer, the bit
+// at offset is the least significant. Width must be between 0 and 64.
+func (s BitVector) GetBits(offset, width int) uint64 {
+	value, err := s.TryGetBits(offset, width)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Returns the width bit field starting at offset, or ErrIndexOutOfRange.
+func (s BitVector) TryGetBits(offset, width int) (uint64, error) {
+	if err := s.checkField(offset, width); err != nil {
+		return 0, err
+	}
+
+	return s.getBits(offset, width), nil
+}
+
+// Returns the width bit field starting at offset as a two's complement signed
+// integer, sign extended from the most significant bit of the field.
+func (s BitVector) GetSignedBits(offset, width int) int64 {
+	value, err := s.TryGetSignedBits(offset, width)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Returns the width bit field starting at offset as a signed integer, or ErrIndexOutOfRange.
+func (s BitVector) TryGetSignedBits(offset, width int) (int64, error) {
+	value, err := s.TryGetBits(offset, width)
+	if err != nil || width == 0 {
+		return 0, err
+	}
+
+	shift := 64 - width
+	return int64(value<<shift) >> shift, nil
+}
+
+// Sets the width bit field starting at offset to value, the least significant
+// bit of value goes to offset. Value must fit in width bits.
+func (s *BitVector) SetBits(offset, width int, value uint64) {
+	if err := s.TrySetBits(offset, width, value); err != nil {
+		panic(err)
+	}
+}
+
+// Sets the width bit field starting at offset to value, or returns ErrIndexOutOfRange.
+func (s *BitVector) TrySetBits(offset, width int, value uint64) error {
+	if err := s.checkField(offset, width); err != nil {
+		return err
+	}
+	if width < 64 && value>>width != 0 {
+		return fmt.Errorf("%w: value %v does not fit in %v bits", ErrIndexOutOfRange, value, width)
+	}
+
+	s.setBits(offset, width, value)
+	s.version++
+	return nil
+}
+
+// Sets the width bit field starting at offset to the two's complement of value,
+// which must be between -2^(width-1) and 2^(width-1)-1.
+func (s *BitVector) SetSignedBits(offset, width int, value int64) {
+	if err := s.TrySetSignedBits(offset, width, value); err != nil {
+		panic(err)
+	}
+}
+
+// Sets the width bit field starting at offset to the two's complement of value, or returns ErrIndexOutOfRange.
+func (s *BitVector) TrySetSignedBits(offset, width int, value int64) error {
+	if err := s.checkField(offset, width); err != nil {
+		return err
+	}
+	if width == 0 && value != 0 || width > 0 && width < 64 && value>>(width-1) != 0 && value>>(width-1) != -1 {
+		return fmt.Errorf("%w: value %v does not fit in %v bits", ErrIndexOutOfRange, value, width)
+	}
+
+	s.setBits(offset, width, uint64(value))
+	s.version++
+	return nil
+}
+
+// checkField returns ErrIndexOutOfRange unless the field lies inside the bitvector.
+func (s BitVector) checkField(offset, width int) error {
+	if width < 0 || width > 64 {
+		return fmt.Errorf("%w: width %v must be between 0 and 64", ErrIndexOutOfRange, width)
+	}
+	if offset < 0 || offset > s.length-width {
+		return fmt.Errorf("%w: field %v to %v", ErrIndexOutOfRange, offset, offset+width)
+	}
+
+	return nil
+}
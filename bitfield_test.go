@@ -0,0 +1,140 @@
+package bitvector_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitVector_GetBits(t *testing.T) {
+	vector := bitvector.NewBitVector(100)
+	for _, i := range []int{0, 2, 31, 32, 33, 63, 64, 99} {
+		vector.Set(i, true)
+	}
+
+	tests := []struct {
+		name   string
+		offset int
+		width  int
+		want   uint64
+	}{
+		{name: "empty", offset: 10, width: 0, want: 0},
+		{name: "first word", offset: 0, width: 3, want: 0b101},
+		{name: "straddle", offset: 30, width: 4, want: 0b1110},
+		{name: "three words", offset: 2, width: 63, want: 1 | 1<<29 | 1<<30 | 1<<31 | 1<<61 | 1<<62},
+		{name: "64 bits", offset: 1, width: 64, want: 1<<1 | 1<<30 | 1<<31 | 1<<32 | 1<<62 | 1<<63},
+		{name: "end", offset: 96, width: 4, want: 0b1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vector.GetBits(tt.offset, tt.width); got != tt.want {
+				t.Errorf("BitVector.GetBits() = %b, want %b", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitVector_SetBits(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		length := 1 + r.Intn(200)
+		vector := randomBitVector(r, length)
+		want := bitvector.Concat(vector)
+
+		width := r.Intn(minTestInt(64, length) + 1)
+		offset := r.Intn(length - width + 1)
+		value := r.Uint64()
+		if width < 64 {
+			value &= 1<<width - 1
+		}
+
+		vector.SetBits(offset, width, value)
+		for j := 0; j < width; j++ {
+			want.Set(offset+j, value>>j&1 == 1)
+		}
+
+		equalBitVector(t, "BitVector.SetBits()", vector, want)
+		if got := vector.GetBits(offset, width); got != value {
+			t.Fatalf("BitVector.GetBits(%v, %v) = %x, want %x", offset, width, got, value)
+		}
+	}
+}
+
+func TestBitVector_SignedBits(t *testing.T) {
+	tests := []struct {
+		width int
+		value int64
+	}{
+		{width: 0, value: 0},
+		{width: 1, value: -1},
+		{width: 1, value: 0},
+		{width: 5, value: -16},
+		{width: 5, value: 15},
+		{width: 5, value: -1},
+		{width: 13, value: -1000},
+		{width: 33, value: -1 << 32},
+		{width: 64, value: -1 << 63},
+		{width: 64, value: 1<<63 - 1},
+	}
+	for _, tt := range tests {
+		for _, offset := range []int{0, 7, 31, 60} {
+			vector := bitvector.NewBitVector(130)
+			vector.SetAll(true)
+
+			vector.SetSignedBits(offset, tt.width, tt.value)
+			if got := vector.GetSignedBits(offset, tt.width); got != tt.value {
+				t.Errorf("BitVector.GetSignedBits(%v, %v) = %v, want %v", offset, tt.width, got, tt.value)
+			}
+			if offset > 0 && !vector.Get(offset-1) || !vector.Get(offset+tt.width) {
+				t.Errorf("BitVector.SetSignedBits(%v, %v) changed bits outside the field", offset, tt.width)
+			}
+		}
+	}
+}
+
+func TestBitVector_TryBits_Errors(t *testing.T) {
+	vector := bitvector.NewBitVector(40)
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "negative offset", err: func() error { _, err := vector.TryGetBits(-1, 4); return err }()},
+		{name: "past length", err: func() error { _, err := vector.TryGetBits(37, 4); return err }()},
+		{name: "negative width", err: func() error { _, err := vector.TryGetBits(0, -1); return err }()},
+		{name: "wide", err: func() error { _, err := bitvector.NewBitVector(100).TryGetBits(0, 65); return err }()},
+		{name: "signed past length", err: func() error { _, err := vector.TryGetSignedBits(39, 2); return err }()},
+		{name: "set past length", err: vector.TrySetBits(36, 5, 0)},
+		{name: "value too wide", err: vector.TrySetBits(0, 4, 16)},
+		{name: "signed too large", err: vector.TrySetSignedBits(0, 4, 8)},
+		{name: "signed too small", err: vector.TrySetSignedBits(0, 4, -9)},
+		{name: "signed zero width", err: vector.TrySetSignedBits(0, 0, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, bitvector.ErrIndexOutOfRange) {
+				t.Errorf("error = %v, want %v", tt.err, bitvector.ErrIndexOutOfRange)
+			}
+		})
+	}
+
+	if vector.TrueBits() != 0 {
+		t.Errorf("failed writes changed the bitvector: %v", vector)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("BitVector.GetBits() did not panic")
+		}
+	}()
+	vector.GetBits(30, 11)
+}
+
+func minTestInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,181 @@
+package bitvector
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// BitWriter appends values of 1 to 64 bits to the end of a BitVector, growing
+// it as needed. Values are written least significant bit first.
+type BitWriter struct {
+	vector *BitVector
+}
+
+// Allocates a BitWriter writing into a new empty BitVector.
+func NewBitWriter() *BitWriter {
+	return NewBitWriterTo(NewBitVector(0))
+}
+
+// Allocates a BitWriter appending to the end of vector.
+func NewBitWriterTo(vector *BitVector) *BitWriter {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+
+	return &BitWriter{
+		vector: vector,
+	}
+}
+
+// BitVector returns the bits written so far.
+func (s *BitWriter) BitVector() *BitVector {
+	return s.vector
+}
+
+// Length returns the number of bits written so far.
+func (s *BitWriter) Length() int {
+	return s.vector.Length()
+}
+
+// WriteBit appends a single bit.
+func (s *BitWriter) WriteBit(bit bool) {
+	value := uint64(0)
+	if bit {
+		value = 1
+	}
+	s.write(value, 1)
+}
+
+// WriteBits appends the low n bits of value, n must be between 1 and 64.
+func (s *BitWriter) WriteBits(value uint64, n int) error {
+	if n < 1 || n > 64 {
+		return fmt.Errorf("%w: n %v must be between 1 and 64", ErrIndexOutOfRange, n)
+	}
+
+	s.write(value, n)
+	return nil
+}
+
+// Align pads with false bits up to the next multiple of 8 bits.
+func (s *BitWriter) Align() {
+	if rest := s.vector.Length() % 8; rest > 0 {
+		s.write(0, 8-rest)
+	}
+}
+
+func (s *BitWriter) write(value uint64, n int) {
+	s.vector.AppendBits(value, n)
+}
+
+// BitReader reads values of 1 to 64 bits from a BitVector starting at the first
+// bit, least significant bit first to match BitWriter.
+type BitReader struct {
+	vector *BitVector
+	offset int
+}
+
+// Allocates a BitReader over vector.
+func NewBitReader(vector *BitVector) *BitReader {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+
+	return &BitReader{
+		vector: vector,
+	}
+}
+
+// Offset returns the position of the next bit to read.
+func (s *BitReader) Offset() int {
+	return s.offset
+}
+
+// Remaining returns the number of bits left to read.
+func (s *BitReader) Remaining() int {
+	return s.vector.Length() - s.offset
+}
+
+// ReadBit reads a single bit, it returns io.EOF at the end of the vector.
+func (s *BitReader) ReadBit() (bool, error) {
+	value, err := s.ReadBits(1)
+	return value == 1, err
+}
+
+// ReadBits reads n bits, n must be between 1 and 64. It returns io.EOF when no
+// bits are left and io.ErrUnexpectedEOF when fewer than n are left, in which
+// case nothing is consumed.
+func (s *BitReader) ReadBits(n int) (uint64, error) {
+	value, err := s.PeekBits(n)
+	if err != nil {
+		return 0, err
+	}
+
+	s.offset += n
+	return value, nil
+}
+
+// PeekBits reads n bits without consuming them.
+func (s *BitReader) PeekBits(n int) (uint64, error) {
+	if n < 1 || n > 64 {
+		return 0, fmt.Errorf("%w: n %v must be between 1 and 64", ErrIndexOutOfRange, n)
+	}
+	if err := s.available(n); err != nil {
+		return 0, err
+	}
+
+	return s.vector.getBits(s.offset, n), nil
+}
+
+// Skip moves forward n bits.
+func (s *BitReader) Skip(n int) error {
+	if n < 0 {
+		return ErrNegativeLength
+	}
+	if err := s.available(n); err != nil {
+		return err
+	}
+
+	s.offset += n
+	return nil
+}
+
+// Seek sets the bit offset for the next read, interpreted according to whence
+// as for io.Seeker.
+func (s *BitReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += int64(s.offset)
+	case io.SeekEnd:
+		offset += int64(s.vector.Length())
+	default:
+		return 0, errors.New("invalid whence")
+	}
+
+	if offset < 0 || offset > int64(s.vector.Length()) {
+		return 0, fmt.Errorf("%w: offset %v", ErrIndexOutOfRange, offset)
+	}
+
+	s.offset = int(offset)
+	return offset, nil
+}
+
+// Align skips forward to the next multiple of 8 bits.
+func (s *BitReader) Align() error {
+	if rest := s.offset % 8; rest > 0 {
+		return s.Skip(8 - rest)
+	}
+	return nil
+}
+
+func (s *BitReader) available(n int) error {
+	remaining := s.Remaining()
+	if n > 0 && remaining == 0 {
+		return io.EOF
+	}
+	if n > remaining {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
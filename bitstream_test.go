@@ -0,0 +1,114 @@
+package bitvector_test
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitWriter_BitReader(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	type field struct {
+		value uint64
+		n     int
+	}
+	fields := make([]field, 1000)
+	w := bitvector.NewBitWriter()
+	length := 0
+	for i := range fields {
+		n := r.Intn(64) + 1
+		fields[i] = field{value: r.Uint64() >> (64 - n), n: n}
+		if err := w.WriteBits(fields[i].value, n); err != nil {
+			t.Fatal(err)
+		}
+		length += n
+	}
+
+	if w.Length() != length {
+		t.Fatalf("BitWriter.Length() = %v, want %v", w.Length(), length)
+	}
+
+	reader := bitvector.NewBitReader(w.BitVector())
+	for i, f := range fields {
+		peek, err := reader.PeekBits(f.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := reader.ReadBits(f.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != f.value || peek != f.value {
+			t.Fatalf("BitReader.ReadBits(%v) field %v = %v, want %v", f.n, i, got, f.value)
+		}
+	}
+
+	if _, err := reader.ReadBit(); err != io.EOF {
+		t.Errorf("BitReader.ReadBit() err = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestBitReader_Errors(t *testing.T) {
+	w := bitvector.NewBitWriter()
+	w.WriteBit(true)
+	w.WriteBit(false)
+	w.WriteBit(true)
+
+	reader := bitvector.NewBitReader(w.BitVector())
+	if _, err := reader.ReadBits(4); err != io.ErrUnexpectedEOF {
+		t.Errorf("BitReader.ReadBits(4) err = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if reader.Offset() != 0 {
+		t.Errorf("BitReader.Offset() = %v, want %v", reader.Offset(), 0)
+	}
+	if _, err := reader.ReadBits(65); !errors.Is(err, bitvector.ErrIndexOutOfRange) {
+		t.Errorf("BitReader.ReadBits(65) err = %v, want %v", err, bitvector.ErrIndexOutOfRange)
+	}
+
+	if err := reader.Skip(2); err != nil {
+		t.Fatal(err)
+	}
+	if bit, err := reader.ReadBit(); err != nil || !bit {
+		t.Errorf("BitReader.ReadBit() = %v, %v, want true", bit, err)
+	}
+	if err := reader.Skip(1); err != io.EOF {
+		t.Errorf("BitReader.Skip(1) err = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestBitReader_Seek_Align(t *testing.T) {
+	w := bitvector.NewBitWriter()
+	w.WriteBits(0x5, 3)
+	w.Align()
+	w.WriteBits(0xab, 8)
+
+	if w.Length() != 16 {
+		t.Fatalf("BitWriter.Length() = %v, want %v", w.Length(), 16)
+	}
+
+	reader := bitvector.NewBitReader(w.BitVector())
+	reader.ReadBits(2)
+	if err := reader.Align(); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := reader.ReadBits(8); got != 0xab {
+		t.Errorf("BitReader.ReadBits(8) = %x, want %x", got, 0xab)
+	}
+
+	if offset, err := reader.Seek(-8, io.SeekEnd); err != nil || offset != 8 {
+		t.Errorf("BitReader.Seek() = %v, %v, want %v", offset, err, 8)
+	}
+	if offset, err := reader.Seek(-8, io.SeekCurrent); err != nil || offset != 0 {
+		t.Errorf("BitReader.Seek() = %v, %v, want %v", offset, err, 0)
+	}
+	if got, _ := reader.ReadBits(3); got != 0x5 {
+		t.Errorf("BitReader.ReadBits(3) = %x, want %x", got, 0x5)
+	}
+	if _, err := reader.Seek(17, io.SeekStart); !errors.Is(err, bitvector.ErrIndexOutOfRange) {
+		t.Errorf("BitReader.Seek() err = %v, want %v", err, bitvector.ErrIndexOutOfRange)
+	}
+}
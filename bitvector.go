@@ -117,17 +117,34 @@ func NewBitVectorFromVectorPadStart(vector *BitVector, padding int) *BitVector {
 
 // Returns the bit value at position index.
 func (s BitVector) Get(index int) bool {
+	bit, err := s.TryGet(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return bit
+}
+
+// Returns the bit value at position index, or ErrIndexOutOfRange.
+func (s BitVector) TryGet(index int) (bool, error) {
 	if index < 0 || index >= s.Length() {
-		panic(fmt.Sprintf("index %v out of range", index))
+		return false, fmt.Errorf("%w: index %v", ErrIndexOutOfRange, index)
 	}
 
-	return (s.array[index/bitsPerInt32] & (1 << (index % bitsPerInt32))) != 0
+	return (s.array[index/bitsPerInt32] & (1 << (index % bitsPerInt32))) != 0, nil
 }
 
 // Sets the bit value at position index to value.
 func (s BitVector) Set(index int, bit bool) {
+	if err := s.TrySet(index, bit); err != nil {
+		panic(err)
+	}
+}
+
+// Sets the bit value at position index to value, or returns ErrIndexOutOfRange.
+func (s BitVector) TrySet(index int, bit bool) error {
 	if index < 0 || index >= s.Length() {
-		panic(fmt.Sprintf("index %v out of range", index))
+		return fmt.Errorf("%w: index %v", ErrIndexOutOfRange, index)
 	}
 
 	if bit {
@@ -137,6 +154,7 @@ func (s BitVector) Set(index int, bit bool) {
 	}
 
 	s.version++
+	return nil
 }
 
 // Sets all the bit values to value.
@@ -159,38 +177,53 @@ func (s *BitVector) SetAll(bit bool) {
 }
 
 func (s *BitVector) Copy(vector *BitVector, indexStart, indexEnd int) {
-	if indexStart < 0 {
-		panic("indexStart must be non negative number")
+	if err := s.TryCopy(vector, indexStart, indexEnd); err != nil {
+		panic(err)
 	}
+}
 
-	if indexEnd > s.Length() {
-		panic("indexEnd must be equal to or less than bitvector")
+// TryCopy is Copy returning ErrNilVector, ErrIndexOutOfRange or ErrLengthMismatch instead of panicking.
+func (s *BitVector) TryCopy(vector *BitVector, indexStart, indexEnd int) error {
+	if vector == nil {
+		return ErrNilVector
 	}
 
-	if vector.Length() < (s.Length()-indexStart)-indexEnd {
-		panic("invalid vector length is to small")
+	if indexStart < 0 {
+		return fmt.Errorf("%w: indexStart must be non negative number", ErrIndexOutOfRange)
 	}
 
-	var err error
+	if indexEnd > s.Length() {
+		return fmt.Errorf("%w: indexEnd must be equal to or less than bitvector", ErrIndexOutOfRange)
+	}
 
-	arrayEnd := 0
-	if indexEnd > 0 {
-		arrayEnd, err = getArrayLength(indexEnd+1, bitsPerInt32)
-		if err != nil {
-			panic(err)
-		}
-		arrayEnd--
+	if vector.Length() < (s.Length()-indexStart)-indexEnd {
+		return fmt.Errorf("%w: invalid vector length is to small", ErrLengthMismatch)
 	}
 
 	arrayStart := 0
 	if indexStart > 0 {
+		var err error
 		arrayStart, err = getArrayLength(indexStart+1, bitsPerInt32)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		arrayStart--
 	}
 
+	// indexEnd is exclusive, so the last word read holds bit indexEnd-1.
+	arrayEnd := arrayStart
+	if indexEnd > 0 {
+		arrayEnd = maxInt(arrayStart, (indexEnd-1)/bitsPerInt32)
+	}
+
+	if arrayEnd >= len(s.array) {
+		return fmt.Errorf("%w: indexEnd %v runs past the last word", ErrIndexOutOfRange, indexEnd)
+	}
+
+	if len(vector.array) < arrayEnd-arrayStart+1 {
+		return fmt.Errorf("%w: invalid vector length is to small", ErrLengthMismatch)
+	}
+
 	index := 0
 	offset := indexStart % bitsPerInt32
 
@@ -201,6 +234,7 @@ func (s *BitVector) Copy(vector *BitVector, indexStart, indexEnd int) {
 
 	vector.array[index] = s.array[arrayEnd] >> offset
 	vector.version++
+	return nil
 }
 
 func (s *BitVector) Length() int {
@@ -208,39 +242,202 @@ func (s *BitVector) Length() int {
 }
 
 func (s *BitVector) Resize(length int) {
+	if err := s.TryResize(length); err != nil {
+		panic(err)
+	}
+}
+
+// TryResize is Resize returning ErrNegativeLength instead of panicking.
+func (s *BitVector) TryResize(length int) error {
 	if length < 0 {
-		panic(fmt.Errorf("need non-negative number"))
+		return ErrNegativeLength
 	}
 
-	arrayLength, err := getArrayLength(length, bitsPerInt32)
+	if length > s.length {
+		s.grow(length)
+	} else {
+		arrayLength, err := getArrayLength(length, bitsPerInt32)
+		if err != nil {
+			return err
+		}
+
+		s.array = s.array[:arrayLength]
+		s.length = length
+		s.clearTail()
+	}
+
+	s.version++
+	return nil
+}
+
+// Cap returns the number of bits the bitvector can hold before it has to reallocate.
+func (s *BitVector) Cap() int {
+	return cap(s.array) * bitsPerInt32
+}
+
+// Grow increases the capacity, if necessary, so another n bits can be appended without reallocating.
+func (s *BitVector) Grow(n int) {
+	if n < 0 {
+		panic(ErrNegativeLength)
+	}
 
+	arrayLength, err := getArrayLength(s.length+n, bitsPerInt32)
 	if err != nil {
 		panic(err)
 	}
 
-	if length != s.length {
-		newarray := make([]uint32, arrayLength)
-		if len(s.array) != 0 {
-			copy(newarray, s.array[:arrayLength])
-		}
-		s.array = newarray
+	if arrayLength > cap(s.array) {
+		array := make([]uint32, len(s.array), arrayLength)
+		copy(array, s.array)
+		s.array = array
 	}
+}
 
-	if length > s.length {
-		last, err := getArrayLength(s.length, bitsPerInt32)
-		if err != nil {
-			panic(err)
-		}
-		last--
+// Shrink releases any capacity beyond the length.
+func (s *BitVector) Shrink() {
+	if cap(s.array) > len(s.array) {
+		array := make([]uint32, len(s.array))
+		copy(array, s.array)
+		s.array = array
+	}
+}
+
+// Append adds bit to the end of the bitvector in amortised O(1).
+func (s *BitVector) Append(bit bool) {
+	value := uint64(0)
+	if bit {
+		value = 1
+	}
+	s.AppendBits(value, 1)
+}
+
+// AppendBits adds the low width bits of value to the end of the bitvector, least
+// significant bit first. width must be between 0 and 64.
+func (s *BitVector) AppendBits(value uint64, width int) {
+	if width < 0 || width > 64 {
+		panic(fmt.Errorf("%w: width %v must be between 0 and 64", ErrIndexOutOfRange, width))
+	}
+
+	offset := s.length
+	s.grow(offset + width)
+	s.setBits(offset, width, value)
+	s.version++
+}
+
+// AppendVector adds the bits of vector to the end of the bitvector.
+func (s *BitVector) AppendVector(vector *BitVector) {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+
+	offset := s.length
+	length := vector.length
+	s.grow(offset + length)
 
-		bits := s.length % bitsPerInt32
-		if bits > 0 {
-			s.array[last] &= (1 << bits) - 1
+	// vector may be s, only bits below length are read and they are never overwritten.
+	for i := 0; i*bitsPerInt32 < length; i++ {
+		n := length - i*bitsPerInt32
+		if n > bitsPerInt32 {
+			n = bitsPerInt32
 		}
+		s.setBits(offset+i*bitsPerInt32, n, uint64(vector.array[i]))
+	}
+	s.version++
+}
+
+// Pop removes and returns the last bit.
+func (s *BitVector) Pop() bool {
+	if s.length == 0 {
+		panic(fmt.Errorf("%w: pop from empty bitvector", ErrIndexOutOfRange))
+	}
+
+	bit := s.Get(s.length - 1)
+	if err := s.TryResize(s.length - 1); err != nil {
+		panic(err)
+	}
+	return bit
+}
+
+// grow extends the bitvector to length, doubling the word array when it runs
+// out of capacity so repeated growth is amortised O(1). The new bits are false.
+func (s *BitVector) grow(length int) {
+	arrayLength, err := getArrayLength(length, bitsPerInt32)
+	if err != nil {
+		panic(err)
+	}
+
+	if arrayLength > cap(s.array) {
+		array := make([]uint32, len(s.array), 2*arrayLength)
+		copy(array, s.array)
+		s.array = array
+	}
+
+	// Clear any stale bits past the old length before they become visible.
+	last := s.length / bitsPerInt32
+	if rest := s.length % bitsPerInt32; rest > 0 {
+		s.array[last] &= (1 << rest) - 1
+		last++
+	}
+	s.array = s.array[:arrayLength]
+	for i := last; i < arrayLength; i++ {
+		s.array[i] = 0
 	}
 
 	s.length = length
-	s.version++
+}
+
+// maskedWord returns word i of the array with any bits past the length cleared.
+func (s *BitVector) maskedWord(i int) uint32 {
+	word := s.array[i]
+	if rest := s.length - i*bitsPerInt32; rest < bitsPerInt32 {
+		word &= (1 << rest) - 1
+	}
+	return word
+}
+
+// clearTail clears any bits in the last word past the length.
+func (s *BitVector) clearTail() {
+	if rest := s.length % bitsPerInt32; rest > 0 {
+		s.array[s.length/bitsPerInt32] &= (1 << rest) - 1
+	}
+}
+
+// getBits reads width bits starting at offset, the first bit is the least significant.
+func (s *BitVector) getBits(offset, width int) uint64 {
+	value := uint64(0)
+	for read := 0; read < width; {
+		index := offset + read
+		shift := index % bitsPerInt32
+
+		n := bitsPerInt32 - shift
+		if n > width-read {
+			n = width - read
+		}
+
+		chunk := uint64(s.array[index/bitsPerInt32]>>shift) & (1<<n - 1)
+		value |= chunk << read
+		read += n
+	}
+	return value
+}
+
+// setBits writes the low width bits of value starting at offset.
+func (s *BitVector) setBits(offset, width int, value uint64) {
+	for written := 0; written < width; {
+		index := offset + written
+		shift := index % bitsPerInt32
+
+		n := bitsPerInt32 - shift
+		if n > width-written {
+			n = width - written
+		}
+
+		mask := uint32(1<<n-1) << shift
+		chunk := uint32(value>>written) << shift
+		word := &s.array[index/bitsPerInt32]
+		*word = (*word &^ mask) | (chunk & mask)
+		written += n
+	}
 }
 
 func getArrayLength(n int, div int) (int, error) {
@@ -253,12 +450,40 @@ func getArrayLength(n int, div int) (int, error) {
 	return 0, nil
 }
 
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 // Rank counts the number of true or false (depending on what the bit is set to)
 // in the bitvector but not including the offset
 func (s *BitVector) Rank(bit bool, offset int) int {
+	rank, err := s.TryRank(bit, offset)
+	if err != nil {
+		panic(err)
+	}
+
+	return rank
+}
+
+// TryRank is Rank returning ErrIndexOutOfRange instead of panicking.
+func (s *BitVector) TryRank(bit bool, offset int) (int, error) {
 	rank := 0
 
-	iterator := s.EnumerateFromOffset(0, offset)
+	iterator, err := s.TryEnumerateFromOffset(0, offset)
+	if err != nil {
+		return 0, err
+	}
+
 	for iterator.HasNext() {
 		v, _ := iterator.Next()
 
@@ -267,7 +492,7 @@ func (s *BitVector) Rank(bit bool, offset int) int {
 		}
 	}
 
-	return rank
+	return rank, nil
 }
 
 // find the offset of true or false (depending on what the bit is set to) from the rank
@@ -292,36 +517,25 @@ func (s *BitVector) Select(bit bool, rank int) int {
 	return match
 }
 
+// Concat returns a new BitVector holding the bits of s followed by the bits of each vector in order.
 func (s *BitVector) Concat(vectors []*BitVector) *BitVector {
+	return Concat(append([]*BitVector{s}, vectors...)...)
+}
 
-	length := s.Length()
+// Concat returns a new BitVector holding the bits of each vector in order.
+func Concat(vectors ...*BitVector) *BitVector {
+	length := 0
 	for _, v := range vectors {
+		if v == nil {
+			panic(ErrNilVector)
+		}
 		length += v.Length()
 	}
 
-	vector := NewBitVector(length)
-
-	iterator := s.Enumerate()
-
-	for iterator.HasNext() {
-		value, i := iterator.Next()
-
-		vector.Set(i, value)
-	}
-
-	index := s.Length()
-	for i, v := range vectors {
-		index += i
-
-		iterator := v.Enumerate()
-
-		for iterator.HasNext() {
-			value, i := iterator.Next()
-
-			index += i
-			vector.Set(index, value)
-		}
-
+	vector := NewBitVector(0)
+	vector.Grow(length)
+	for _, v := range vectors {
+		vector.AppendVector(v)
 	}
 	return vector
 }
@@ -343,17 +557,16 @@ func (s *BitVector) TrueBits() int {
 
 // ANDed with vector.
 func (s *BitVector) And(vector *BitVector) {
-	if vector == nil {
-		panic(fmt.Errorf("vector is null"))
-	}
-
-	if s.Length() != vector.Length() {
-		panic(fmt.Errorf("vector length is different"))
+	if err := s.TryAnd(vector); err != nil {
+		panic(err)
 	}
+}
 
-	arrayLength, err := getArrayLength(s.length, bitsPerInt32)
+// ANDed with vector, or returns ErrNilVector or ErrLengthMismatch.
+func (s *BitVector) TryAnd(vector *BitVector) error {
+	arrayLength, err := s.operand(vector)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	for i := 0; i < arrayLength; i++ {
@@ -361,21 +574,21 @@ func (s *BitVector) And(vector *BitVector) {
 	}
 
 	s.version++
+	return nil
 }
 
 // ORed with vector.
 func (s *BitVector) Or(vector *BitVector) {
-	if vector == nil {
-		panic(fmt.Errorf("vector is null"))
-	}
-
-	if s.Length() != vector.Length() {
-		panic(fmt.Errorf("vector length is different"))
+	if err := s.TryOr(vector); err != nil {
+		panic(err)
 	}
+}
 
-	arrayLength, err := getArrayLength(s.length, bitsPerInt32)
+// ORed with vector, or returns ErrNilVector or ErrLengthMismatch.
+func (s *BitVector) TryOr(vector *BitVector) error {
+	arrayLength, err := s.operand(vector)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	for i := 0; i < arrayLength; i++ {
@@ -383,21 +596,21 @@ func (s *BitVector) Or(vector *BitVector) {
 	}
 
 	s.version++
+	return nil
 }
 
 // XORed with vector.
 func (s *BitVector) Xor(vector *BitVector) {
-	if vector == nil {
-		panic(fmt.Errorf("vector is null"))
-	}
-
-	if s.Length() != vector.Length() {
-		panic(fmt.Errorf("vector length is different"))
+	if err := s.TryXor(vector); err != nil {
+		panic(err)
 	}
+}
 
-	arrayLength, err := getArrayLength(s.length, bitsPerInt32)
+// XORed with vector, or returns ErrNilVector or ErrLengthMismatch.
+func (s *BitVector) TryXor(vector *BitVector) error {
+	arrayLength, err := s.operand(vector)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	for i := 0; i < arrayLength; i++ {
@@ -405,6 +618,20 @@ func (s *BitVector) Xor(vector *BitVector) {
 	}
 
 	s.version++
+	return nil
+}
+
+// operand checks vector can be combined with s and returns the number of words to combine.
+func (s *BitVector) operand(vector *BitVector) (int, error) {
+	if vector == nil {
+		return 0, ErrNilVector
+	}
+
+	if s.Length() != vector.Length() {
+		return 0, fmt.Errorf("%w: vector length is different", ErrLengthMismatch)
+	}
+
+	return getArrayLength(s.length, bitsPerInt32)
 }
 
 // Inverts all the bit values. On/true bit values are converted to off/false. Off/false bit values are turned on/true.
@@ -441,6 +668,11 @@ func (s *BitVector) EnumerateFromOffset(indexStart, indexEnd int) *BitVectorIter
 	return NewBitVectorIteratorWithOffset(s, indexStart, indexEnd)
 }
 
+// TryEnumerateFromOffset is EnumerateFromOffset returning ErrIndexOutOfRange instead of panicking.
+func (s *BitVector) TryEnumerateFromOffset(indexStart, indexEnd int) (*BitVectorIterator, error) {
+	return newBitVectorIterator(s, indexStart, indexEnd)
+}
+
 type BitVectorIterator struct {
 	vector         *BitVector
 	version        int
@@ -450,23 +682,36 @@ type BitVectorIterator struct {
 }
 
 func NewBitVectorIteratorWithOffset(vector *BitVector, indexStart, indexEnd int) *BitVectorIterator {
+	iterator, err := newBitVectorIterator(vector, indexStart, indexEnd)
+	if err != nil {
+		panic(err)
+	}
+
+	return iterator
+}
+
+func newBitVectorIterator(vector *BitVector, indexStart, indexEnd int) (*BitVectorIterator, error) {
+	if vector == nil {
+		return nil, ErrNilVector
+	}
+
 	if indexStart > vector.Length() {
-		panic("indexStart grater or equal to length")
+		return nil, fmt.Errorf("%w: indexStart grater or equal to length", ErrIndexOutOfRange)
 	}
 	if indexStart < 0 {
-		panic("indexStart must be non negative number")
+		return nil, fmt.Errorf("%w: indexStart must be non negative number", ErrIndexOutOfRange)
 	}
 
 	if indexStart > indexEnd {
-		panic("indexEnd must be greater then indexStart")
+		return nil, fmt.Errorf("%w: indexEnd must be greater then indexStart", ErrIndexOutOfRange)
 	}
 
 	if vector.Length()-indexStart < 0 {
-		panic("invalid indexStart length")
+		return nil, fmt.Errorf("%w: invalid indexStart length", ErrIndexOutOfRange)
 	}
 
 	if indexEnd > vector.Length() {
-		panic("indexEnd must be greater then vector length")
+		return nil, fmt.Errorf("%w: indexEnd must be greater then vector length", ErrIndexOutOfRange)
 	}
 
 	return &BitVectorIterator{
@@ -474,14 +719,22 @@ func NewBitVectorIteratorWithOffset(vector *BitVector, indexStart, indexEnd int)
 		indexStart: indexStart,
 		indexEnd:   indexEnd,
 		version:    vector.version,
-	}
+	}, nil
 }
 
 func (s *BitVectorIterator) Reset() {
+	if err := s.TryReset(); err != nil {
+		panic(err)
+	}
+}
+
+// TryReset is Reset returning ErrConcurrentModification instead of panicking.
+func (s *BitVectorIterator) TryReset() error {
 	if s.version != s.vector.version {
-		panic("version failed")
+		return ErrConcurrentModification
 	}
 	s.indexStart = 0
+	return nil
 }
 
 func (s *BitVectorIterator) HasNext() bool {
@@ -489,8 +742,18 @@ func (s *BitVectorIterator) HasNext() bool {
 }
 
 func (s *BitVectorIterator) Next() (bool, int) {
+	currentElement, index, err := s.TryNext()
+	if err != nil {
+		panic(err)
+	}
+
+	return currentElement, index
+}
+
+// TryNext is Next returning ErrConcurrentModification instead of panicking.
+func (s *BitVectorIterator) TryNext() (bool, int, error) {
 	if s.version != s.vector.version {
-		panic("version failed")
+		return false, 0, ErrConcurrentModification
 	}
 
 	if s.indexStart < s.vector.Length() {
@@ -498,10 +761,10 @@ func (s *BitVectorIterator) Next() (bool, int) {
 		currentElement := s.vector.Get(s.indexStart)
 		s.currentElement = currentElement
 		s.indexStart++
-		return currentElement, index
+		return currentElement, index, nil
 	}
 
 	s.indexStart = s.vector.Length()
 
-	return false, s.indexStart
+	return false, s.indexStart, nil
 }
@@ -195,6 +195,20 @@ func TestBitVector_Copy(t *testing.T) {
 			indexStart: 10,
 			indexEnd:   86,
 		},
+		{
+			name: "Copy aligned length",
+			values: []bool{
+				true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true,
+				true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true,
+			},
+			want: []bool{
+				true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true,
+				true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true,
+			},
+			length:     64,
+			indexStart: 0,
+			indexEnd:   64,
+		},
 		{
 			name: "Copy over array bounds",
 			values: []bool{
@@ -550,3 +564,166 @@ func TestBitVector_String(t *testing.T) {
 		})
 	}
 }
+
+func TestBitVector_Append_Pop(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []bool
+	}{
+		{
+			name:   "Append",
+			values: []bool{true, false, true, true, true},
+		},
+		{
+			name:   "Pop true after false",
+			values: []bool{false, true},
+		},
+		{
+			name: "Append over array bounds",
+			values: []bool{
+				true, true, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false,
+				true, false, true, true, true, true, true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bitvector.NewBitVector(0)
+			for _, value := range tt.values {
+				s.Append(value)
+			}
+
+			if s.Length() != len(tt.values) {
+				t.Fatalf("BitVector.Length() = %v, want %v", s.Length(), len(tt.values))
+			}
+			if s.Cap() < s.Length() {
+				t.Errorf("BitVector.Cap() = %v, want >= %v", s.Cap(), s.Length())
+			}
+			for i := len(tt.values) - 1; i >= 0; i-- {
+				if got := s.Pop(); got != tt.values[i] {
+					t.Errorf("BitVector.Pop() = %v, want %v", got, tt.values[i])
+				}
+
+				want := 0
+				for _, value := range tt.values[:i] {
+					if value {
+						want++
+					}
+				}
+				if got := s.TrueBits(); got != want {
+					t.Errorf("BitVector.TrueBits() after Pop = %v, want %v", got, want)
+				}
+			}
+			if s.Length() != 0 {
+				t.Errorf("BitVector.Length() = %v, want %v", s.Length(), 0)
+			}
+		})
+	}
+}
+
+func TestBitVector_AppendBits(t *testing.T) {
+	s := bitvector.NewBitVectorOfLength(3, true)
+	s.AppendBits(0x5, 3)
+	s.AppendBits(0xffffffffff, 40)
+
+	want := []bool{true, true, true, true, false, true}
+	for i := 0; i < 40; i++ {
+		want = append(want, true)
+	}
+	if s.Length() != len(want) {
+		t.Fatalf("BitVector.Length() = %v, want %v", s.Length(), len(want))
+	}
+	for i := range want {
+		if got := s.Get(i); got != want[i] {
+			t.Errorf("BitVector.Get(%v) = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestBitVector_AppendVector(t *testing.T) {
+	left := []bool{true, false, true}
+	right := make([]bool, 70)
+	for i := range right {
+		right[i] = i%3 == 0
+	}
+
+	s := bitvector.NewBitVectorFromBool(left)
+	s.AppendVector(bitvector.NewBitVectorFromBool(right))
+	s.AppendVector(s)
+
+	want := append(append([]bool{}, left...), right...)
+	want = append(want, want...)
+	if s.Length() != len(want) {
+		t.Fatalf("BitVector.Length() = %v, want %v", s.Length(), len(want))
+	}
+	for i := range want {
+		if got := s.Get(i); got != want[i] {
+			t.Errorf("BitVector.Get(%v) = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestBitVector_Grow_Shrink(t *testing.T) {
+	s := bitvector.NewBitVector(10)
+	s.Grow(100)
+	if s.Cap() < 110 {
+		t.Errorf("BitVector.Cap() = %v, want >= %v", s.Cap(), 110)
+	}
+	if s.Length() != 10 {
+		t.Errorf("BitVector.Length() = %v, want %v", s.Length(), 10)
+	}
+
+	s.Shrink()
+	if s.Cap() != 32 {
+		t.Errorf("BitVector.Cap() = %v, want %v", s.Cap(), 32)
+	}
+
+	s.SetAll(true)
+	s.Resize(5)
+	s.Resize(70)
+	for i := 5; i < 70; i++ {
+		if s.Get(i) {
+			t.Fatalf("BitVector.Get(%v) = true, want false", i)
+		}
+	}
+}
+
+func TestConcat(t *testing.T) {
+	lengths := []int{0, 1, 5, 31, 32, 33, 63, 64, 65, 100}
+	pattern := func(length, seed int) []bool {
+		values := make([]bool, length)
+		for i := range values {
+			values[i] = (i*7+seed)%3 == 0
+		}
+		return values
+	}
+
+	for _, a := range lengths {
+		for _, b := range lengths {
+			for _, c := range lengths {
+				values := [][]bool{pattern(a, 1), pattern(b, 2), pattern(c, 3)}
+				want := []bool{}
+				vectors := []*bitvector.BitVector{}
+				for _, v := range values {
+					want = append(want, v...)
+					vectors = append(vectors, bitvector.NewBitVectorFromBool(v))
+				}
+
+				got := bitvector.Concat(vectors...)
+				method := vectors[0].Concat(vectors[1:])
+				if got.Length() != len(want) || method.Length() != len(want) {
+					t.Fatalf("Concat(%v, %v, %v).Length() = %v, %v, want %v", a, b, c, got.Length(), method.Length(), len(want))
+				}
+				for i := range want {
+					if got.Get(i) != want[i] || method.Get(i) != want[i] {
+						t.Fatalf("Concat(%v, %v, %v).Get(%v) = %v, %v, want %v", a, b, c, i, got.Get(i), method.Get(i), want[i])
+					}
+				}
+			}
+		}
+	}
+
+	if got := bitvector.Concat(); got.Length() != 0 {
+		t.Errorf("Concat().Length() = %v, want %v", got.Length(), 0)
+	}
+}
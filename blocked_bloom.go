@@ -0,0 +1,104 @@
+package bitvector
+
+import (
+	"fmt"
+	"math"
+)
+
+// Bits in a block of the BlockedBloomFilter, one 64 byte cache line.
+const bitsPerBlock = 512
+
+// BlockedBloomFilter is a Bloom filter where all k bits of an item fall within a
+// single 512 bit block, so each Add or Test touches one cache line. It has a
+// slightly higher false positive rate than BloomFilter for the same size.
+type BlockedBloomFilter struct {
+	vector *BitVector
+	blocks uint64
+	k      uint64
+}
+
+// Allocates a BlockedBloomFilter sized to hold expectedItems with a false positive rate near falsePositiveRate.
+func NewBlockedBloomFilter(expectedItems int, falsePositiveRate float64) *BlockedBloomFilter {
+	if expectedItems <= 0 {
+		panic(fmt.Errorf("%w: expectedItems must be greater than 0", ErrInvalidArgument))
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		panic(fmt.Errorf("%w: falsePositiveRate must be between 0 and 1", ErrInvalidArgument))
+	}
+
+	n := float64(expectedItems)
+	m := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/n*math.Ln2))
+
+	return NewBlockedBloomFilterOfSize(int(math.Ceil(m/bitsPerBlock)), int(k))
+}
+
+// Allocates a BlockedBloomFilter of blocks 512 bit blocks using k hash functions.
+func NewBlockedBloomFilterOfSize(blocks, k int) *BlockedBloomFilter {
+	if blocks <= 0 || k <= 0 {
+		panic(fmt.Errorf("%w: blocks and k must be greater than 0", ErrInvalidArgument))
+	}
+
+	return &BlockedBloomFilter{
+		vector: NewBitVector(blocks * bitsPerBlock),
+		blocks: uint64(blocks),
+		k:      uint64(k),
+	}
+}
+
+// M returns the number of bits in the filter.
+func (s *BlockedBloomFilter) M() int {
+	return int(s.blocks * bitsPerBlock)
+}
+
+// K returns the number of hash functions used per item.
+func (s *BlockedBloomFilter) K() int {
+	return int(s.k)
+}
+
+// BitVector returns the bits backing the filter.
+func (s *BlockedBloomFilter) BitVector() *BitVector {
+	return s.vector
+}
+
+// Add inserts data into the filter.
+func (s *BlockedBloomFilter) Add(data []byte) {
+	block, h1, h2 := s.hash(data)
+	for i := uint64(0); i < s.k; i++ {
+		bit := (h1 + i*h2) % bitsPerBlock
+		block[bit/bitsPerInt32] |= 1 << (bit % bitsPerInt32)
+	}
+	s.vector.version++
+}
+
+// Test reports whether data may be in the filter.
+func (s *BlockedBloomFilter) Test(data []byte) bool {
+	block, h1, h2 := s.hash(data)
+	for i := uint64(0); i < s.k; i++ {
+		bit := (h1 + i*h2) % bitsPerBlock
+		if block[bit/bitsPerInt32]&(1<<(bit%bitsPerInt32)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Union merges filter into s, both filters must have the same number of blocks and k.
+func (s *BlockedBloomFilter) Union(filter *BlockedBloomFilter) error {
+	if filter == nil {
+		return ErrNilVector
+	}
+	if s.blocks != filter.blocks || s.k != filter.k {
+		return fmt.Errorf("%w: bloom filters differ in blocks or k", ErrLengthMismatch)
+	}
+	return s.vector.TryOr(filter.vector)
+}
+
+// hash picks the block for data from the first hash and derives the in-block
+// probes from the second.
+func (s *BlockedBloomFilter) hash(data []byte) ([]uint32, uint64, uint64) {
+	h1, h2 := bloomHash(data)
+	start := (h1 % s.blocks) * (bitsPerBlock / bitsPerInt32)
+	block := s.vector.array[start : start+bitsPerBlock/bitsPerInt32]
+	return block, h2 >> 32, (h2 & 0xffffffff) | 1
+}
@@ -0,0 +1,139 @@
+package bitvector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBlockedBloomFilter_Add_Test(t *testing.T) {
+	tests := []struct {
+		name              string
+		expectedItems     int
+		falsePositiveRate float64
+	}{
+		{
+			name:              "one percent",
+			expectedItems:     1000,
+			falsePositiveRate: 0.01,
+		},
+		{
+			name:              "tenth of a percent",
+			expectedItems:     5000,
+			falsePositiveRate: 0.001,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bitvector.NewBlockedBloomFilter(tt.expectedItems, tt.falsePositiveRate)
+			for i := 0; i < tt.expectedItems; i++ {
+				s.Add([]byte(fmt.Sprintf("item-%v", i)))
+			}
+
+			for i := 0; i < tt.expectedItems; i++ {
+				if !s.Test([]byte(fmt.Sprintf("item-%v", i))) {
+					t.Fatalf("BlockedBloomFilter.Test(item-%v) = false, want true", i)
+				}
+			}
+
+			falsePositives := 0
+			trials := 100000
+			for i := 0; i < trials; i++ {
+				if s.Test([]byte(fmt.Sprintf("other-%v", i))) {
+					falsePositives++
+				}
+			}
+			if rate := float64(falsePositives) / float64(trials); rate > tt.falsePositiveRate*4 {
+				t.Errorf("false positive rate = %v, want <= %v", rate, tt.falsePositiveRate*4)
+			}
+		})
+	}
+}
+
+func TestBlockedBloomFilter_Union(t *testing.T) {
+	left := bitvector.NewBlockedBloomFilter(100, 0.01)
+	right := bitvector.NewBlockedBloomFilter(100, 0.01)
+	left.Add([]byte("a"))
+	right.Add([]byte("b"))
+
+	if err := left.Union(right); err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range []string{"a", "b"} {
+		if !left.Test([]byte(item)) {
+			t.Errorf("BlockedBloomFilter.Union() missing %v", item)
+		}
+	}
+}
+
+func benchmarkKeys(n int) [][]byte {
+	keys := make([][]byte, n)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("item-%v", i))
+	}
+	return keys
+}
+
+func BenchmarkBloomFilter_Test(b *testing.B) {
+	keys := benchmarkKeys(1 << 20)
+	s := bitvector.NewBloomFilter(len(keys), 0.01)
+	for _, key := range keys {
+		s.Add(key)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Test(keys[i&(len(keys)-1)])
+	}
+}
+
+func BenchmarkBlockedBloomFilter_Test(b *testing.B) {
+	keys := benchmarkKeys(1 << 20)
+	s := bitvector.NewBlockedBloomFilter(len(keys), 0.01)
+	for _, key := range keys {
+		s.Add(key)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Test(keys[i&(len(keys)-1)])
+	}
+}
+
+func BenchmarkBloomFilter_Add(b *testing.B) {
+	keys := benchmarkKeys(1 << 20)
+	s := bitvector.NewBloomFilter(len(keys), 0.01)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Add(keys[i&(len(keys)-1)])
+	}
+}
+
+func BenchmarkBlockedBloomFilter_Add(b *testing.B) {
+	keys := benchmarkKeys(1 << 20)
+	s := bitvector.NewBlockedBloomFilter(len(keys), 0.01)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Add(keys[i&(len(keys)-1)])
+	}
+}
+
+func TestNewBlockedBloomFilter_Panics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "no items", fn: func() { bitvector.NewBlockedBloomFilter(0, 0.01) }},
+		{name: "zero rate", fn: func() { bitvector.NewBlockedBloomFilter(100, 0) }},
+		{name: "zero blocks", fn: func() { bitvector.NewBlockedBloomFilterOfSize(0, 3) }},
+		{name: "zero k", fn: func() { bitvector.NewBlockedBloomFilterOfSize(1, 0) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			panicsWith(t, bitvector.ErrInvalidArgument, tt.fn)
+		})
+	}
+}
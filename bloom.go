@@ -0,0 +1,184 @@
+package bitvector
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// BloomFilter is a probabilistic set membership test stored in a BitVector.
+// Test may report false positives at roughly the rate the filter was sized for,
+// but never false negatives.
+type BloomFilter struct {
+	vector *BitVector
+	m      uint64
+	k      uint64
+}
+
+// Allocates a BloomFilter sized to hold expectedItems with a false positive rate of falsePositiveRate.
+func NewBloomFilter(expectedItems int, falsePositiveRate float64) *BloomFilter {
+	if expectedItems <= 0 {
+		panic(fmt.Errorf("%w: expectedItems must be greater than 0", ErrInvalidArgument))
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		panic(fmt.Errorf("%w: falsePositiveRate must be between 0 and 1", ErrInvalidArgument))
+	}
+
+	n := float64(expectedItems)
+	m := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/n*math.Ln2))
+
+	return NewBloomFilterOfSize(int(m), int(k))
+}
+
+// Allocates a BloomFilter of m bits using k hash functions.
+func NewBloomFilterOfSize(m, k int) *BloomFilter {
+	if m <= 0 || k <= 0 {
+		panic(fmt.Errorf("%w: m and k must be greater than 0", ErrInvalidArgument))
+	}
+
+	return &BloomFilter{
+		vector: NewBitVector(m),
+		m:      uint64(m),
+		k:      uint64(k),
+	}
+}
+
+// M returns the number of bits in the filter.
+func (s *BloomFilter) M() int {
+	return int(s.m)
+}
+
+// K returns the number of hash functions used per item.
+func (s *BloomFilter) K() int {
+	return int(s.k)
+}
+
+// BitVector returns the bits backing the filter.
+func (s *BloomFilter) BitVector() *BitVector {
+	return s.vector
+}
+
+// Add inserts data into the filter.
+func (s *BloomFilter) Add(data []byte) {
+	h1, h2 := bloomHash(data)
+	for i := uint64(0); i < s.k; i++ {
+		s.vector.Set(int((h1+i*h2)%s.m), true)
+	}
+}
+
+// Test reports whether data may be in the filter.
+func (s *BloomFilter) Test(data []byte) bool {
+	h1, h2 := bloomHash(data)
+	for i := uint64(0); i < s.k; i++ {
+		if !s.vector.Get(int((h1 + i*h2) % s.m)) {
+			return false
+		}
+	}
+	return true
+}
+
+// TestAndAdd reports whether data may have been in the filter before adding it.
+func (s *BloomFilter) TestAndAdd(data []byte) bool {
+	present := true
+	h1, h2 := bloomHash(data)
+	for i := uint64(0); i < s.k; i++ {
+		index := int((h1 + i*h2) % s.m)
+		if !s.vector.Get(index) {
+			present = false
+			s.vector.Set(index, true)
+		}
+	}
+	return present
+}
+
+// Union merges filter into s, both filters must have the same m and k.
+func (s *BloomFilter) Union(filter *BloomFilter) error {
+	if err := s.compatible(filter); err != nil {
+		return err
+	}
+	return s.vector.TryOr(filter.vector)
+}
+
+// Intersect keeps only the bits set in both s and filter, both filters must have the same m and k.
+func (s *BloomFilter) Intersect(filter *BloomFilter) error {
+	if err := s.compatible(filter); err != nil {
+		return err
+	}
+	return s.vector.TryAnd(filter.vector)
+}
+
+// EstimatedCount approximates the number of distinct items added from the number of set bits.
+func (s *BloomFilter) EstimatedCount() int {
+	m := float64(s.m)
+	x := float64(s.vector.TrueBits())
+	if x >= m {
+		return math.MaxInt
+	}
+	return int(math.Round(-m / float64(s.k) * math.Log(1-x/m)))
+}
+
+// MarshalBinary encodes m, k and the filter bits.
+func (s *BloomFilter) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 16, 16+len(s.vector.array)*4)
+	binary.LittleEndian.PutUint64(data[0:], s.m)
+	binary.LittleEndian.PutUint64(data[8:], s.k)
+	for _, word := range s.vector.array {
+		data = binary.LittleEndian.AppendUint32(data, word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary into s.
+func (s *BloomFilter) UnmarshalBinary(data []byte) error {
+	if len(data) < 16 {
+		return fmt.Errorf("%w: bloom filter header", ErrInvalidEncoding)
+	}
+
+	m := binary.LittleEndian.Uint64(data[0:])
+	k := binary.LittleEndian.Uint64(data[8:])
+	if m == 0 || k == 0 || m > math.MaxInt32*bitsPerInt32 {
+		return fmt.Errorf("%w: bloom filter m %v k %v", ErrInvalidEncoding, m, k)
+	}
+
+	arrayLength, err := getArrayLength(int(m), bitsPerInt32)
+	if err != nil {
+		return err
+	}
+	if len(data)-16 != arrayLength*4 {
+		return fmt.Errorf("%w: bloom filter expected %v bytes got %v", ErrInvalidEncoding, arrayLength*4, len(data)-16)
+	}
+
+	vector := NewBitVector(int(m))
+	for i := range vector.array {
+		vector.array[i] = binary.LittleEndian.Uint32(data[16+i*4:])
+	}
+	if vector.maskedWord(arrayLength-1) != vector.array[arrayLength-1] {
+		return fmt.Errorf("%w: bloom filter has bits set past m", ErrInvalidEncoding)
+	}
+
+	s.vector = vector
+	s.m = m
+	s.k = k
+	return nil
+}
+
+func (s *BloomFilter) compatible(filter *BloomFilter) error {
+	if filter == nil {
+		return ErrNilVector
+	}
+	if s.m != filter.m || s.k != filter.k {
+		return fmt.Errorf("%w: bloom filters differ in m or k", ErrLengthMismatch)
+	}
+	return nil
+}
+
+// bloomHash returns the two hashes combined by double hashing, h2 is forced odd
+// so successive probes never repeat while m is a power of two.
+func bloomHash(data []byte) (uint64, uint64) {
+	hash := fnv.New128a()
+	hash.Write(data)
+	sum := hash.Sum(nil)
+	return binary.BigEndian.Uint64(sum[0:8]), binary.BigEndian.Uint64(sum[8:16]) | 1
+}
@@ -0,0 +1,207 @@
+package bitvector_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBloomFilter_Add_Test(t *testing.T) {
+	tests := []struct {
+		name              string
+		expectedItems     int
+		falsePositiveRate float64
+	}{
+		{
+			name:              "one percent",
+			expectedItems:     1000,
+			falsePositiveRate: 0.01,
+		},
+		{
+			name:              "tenth of a percent",
+			expectedItems:     5000,
+			falsePositiveRate: 0.001,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bitvector.NewBloomFilter(tt.expectedItems, tt.falsePositiveRate)
+			for i := 0; i < tt.expectedItems; i++ {
+				s.Add([]byte(fmt.Sprintf("item-%v", i)))
+			}
+
+			for i := 0; i < tt.expectedItems; i++ {
+				if !s.Test([]byte(fmt.Sprintf("item-%v", i))) {
+					t.Fatalf("BloomFilter.Test(item-%v) = false, want true", i)
+				}
+			}
+
+			falsePositives := 0
+			trials := 100000
+			for i := 0; i < trials; i++ {
+				if s.Test([]byte(fmt.Sprintf("other-%v", i))) {
+					falsePositives++
+				}
+			}
+			if rate := float64(falsePositives) / float64(trials); rate > tt.falsePositiveRate*2 {
+				t.Errorf("false positive rate = %v, want <= %v", rate, tt.falsePositiveRate*2)
+			}
+
+			estimate := s.EstimatedCount()
+			if math.Abs(float64(estimate-tt.expectedItems)) > float64(tt.expectedItems)*0.05 {
+				t.Errorf("BloomFilter.EstimatedCount() = %v, want ~%v", estimate, tt.expectedItems)
+			}
+		})
+	}
+}
+
+func TestBloomFilter_TestAndAdd(t *testing.T) {
+	s := bitvector.NewBloomFilter(100, 0.01)
+	if s.TestAndAdd([]byte("a")) {
+		t.Errorf("BloomFilter.TestAndAdd() = true, want false")
+	}
+	if !s.TestAndAdd([]byte("a")) {
+		t.Errorf("BloomFilter.TestAndAdd() = false, want true")
+	}
+}
+
+func TestBloomFilter_Union_Intersect(t *testing.T) {
+	left := bitvector.NewBloomFilter(100, 0.01)
+	right := bitvector.NewBloomFilter(100, 0.01)
+	left.Add([]byte("a"))
+	left.Add([]byte("both"))
+	right.Add([]byte("b"))
+	right.Add([]byte("both"))
+
+	union := bitvector.NewBloomFilter(100, 0.01)
+	if err := union.Union(left); err != nil {
+		t.Fatal(err)
+	}
+	if err := union.Union(right); err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range []string{"a", "b", "both"} {
+		if !union.Test([]byte(item)) {
+			t.Errorf("BloomFilter.Union() missing %v", item)
+		}
+	}
+
+	if err := left.Intersect(right); err != nil {
+		t.Fatal(err)
+	}
+	if !left.Test([]byte("both")) {
+		t.Errorf("BloomFilter.Intersect() missing both")
+	}
+
+	if err := left.Union(bitvector.NewBloomFilter(10, 0.1)); !errors.Is(err, bitvector.ErrLengthMismatch) {
+		t.Errorf("BloomFilter.Union() err = %v, want %v", err, bitvector.ErrLengthMismatch)
+	}
+}
+
+func TestBloomFilter_MarshalBinary(t *testing.T) {
+	s := bitvector.NewBloomFilter(100, 0.01)
+	for i := 0; i < 100; i++ {
+		s.Add([]byte(fmt.Sprintf("item-%v", i)))
+	}
+
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := &bitvector.BloomFilter{}
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got.M() != s.M() || got.K() != s.K() {
+		t.Errorf("BloomFilter.UnmarshalBinary() m, k = %v, %v, want %v, %v", got.M(), got.K(), s.M(), s.K())
+	}
+	for i := 0; i < 100; i++ {
+		if !got.Test([]byte(fmt.Sprintf("item-%v", i))) {
+			t.Fatalf("BloomFilter.Test(item-%v) = false, want true", i)
+		}
+	}
+
+	if err := got.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, bitvector.ErrInvalidEncoding) {
+		t.Errorf("BloomFilter.UnmarshalBinary() err = %v, want %v", err, bitvector.ErrInvalidEncoding)
+	}
+}
+
+func TestBloomFilter_MarshalBinary_Golden(t *testing.T) {
+	// Serialized filters must keep testing the same after an upgrade, so the
+	// bits each key sets are pinned.
+	want := []byte{
+		0x40, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x3, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x80, 0x64, 0x0, 0x20, 0x0, 0xa0, 0x4,
+	}
+
+	s := bitvector.NewBloomFilterOfSize(64, 3)
+	for _, key := range []string{"alpha", "beta", "gamma"} {
+		s.Add([]byte(key))
+	}
+
+	got, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("BloomFilter.MarshalBinary() = %#v, want %#v", got, want)
+	}
+}
+
+func TestBloomFilter_UnmarshalBinary_Padding(t *testing.T) {
+	data, err := bitvector.NewBloomFilterOfSize(33, 2).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)-1] = 0xff
+
+	s := &bitvector.BloomFilter{}
+	if err := s.UnmarshalBinary(data); !errors.Is(err, bitvector.ErrInvalidEncoding) {
+		t.Errorf("BloomFilter.UnmarshalBinary() err = %v, want %v", err, bitvector.ErrInvalidEncoding)
+	}
+
+	data[len(data)-1] = 0
+	data[len(data)-4] = 0x01
+	if err := s.UnmarshalBinary(data); err != nil {
+		t.Fatalf("BloomFilter.UnmarshalBinary() err = %v", err)
+	}
+	if got := s.BitVector().TrueBits(); got != 1 {
+		t.Errorf("BitVector().TrueBits() = %v, want 1", got)
+	}
+}
+
+func TestNewBloomFilter_Panics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "no items", fn: func() { bitvector.NewBloomFilter(0, 0.01) }},
+		{name: "zero rate", fn: func() { bitvector.NewBloomFilter(100, 0) }},
+		{name: "rate of one", fn: func() { bitvector.NewBloomFilter(100, 1) }},
+		{name: "zero m", fn: func() { bitvector.NewBloomFilterOfSize(0, 3) }},
+		{name: "zero k", fn: func() { bitvector.NewBloomFilterOfSize(64, 0) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			panicsWith(t, bitvector.ErrInvalidArgument, tt.fn)
+		})
+	}
+}
+
+func panicsWith(t *testing.T, want error, fn func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, want) {
+			t.Errorf("recover() = %v, want %v", err, want)
+		}
+	}()
+	fn()
+}
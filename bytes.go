@@ -0,0 +1,132 @@
+package bitvector
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// BitOrder is the layout used to convert between a BitVector and bytes. It is a
+// bit numbering, LSB0 or MSB0, optionally combined with a word layout,
+// LittleEndian32 or BigEndian32, for example BigEndian32 | MSB0.
+type BitOrder int
+
+const (
+	// LSB0 puts bit 0 in the least significant bit of byte 0, bit 8 in byte 1 and
+	// so on. It is also the little endian layout of the uint32 words.
+	LSB0 BitOrder = 0
+	// MSB0 puts bit 0 in the most significant bit of byte 0, as used by most
+	// hardware registers and network protocols. With a word layout bit 0 is the
+	// most significant bit of the first word instead.
+	MSB0 BitOrder = 1
+	// LittleEndian32 groups the bits into uint32 words stored little endian. The
+	// length is padded to a whole number of words.
+	LittleEndian32 BitOrder = 2
+	// BigEndian32 groups the bits into uint32 words stored big endian, so with
+	// LSB0 bit 0 is the least significant bit of byte 3. The length is padded to
+	// a whole number of words.
+	BigEndian32 BitOrder = 4
+)
+
+// FromBytes allocates a BitVector of length bits read from b using order, any
+// bits in b past length are ignored.
+func FromBytes(b []byte, length int, order BitOrder) (*BitVector, error) {
+	if length < 0 {
+		return nil, ErrNegativeLength
+	}
+	if err := order.valid(); err != nil {
+		return nil, err
+	}
+	if need := order.byteLength(length); len(b) < need {
+		return nil, fmt.Errorf("%w: %v bits need %v bytes got %v", ErrLengthMismatch, length, need, len(b))
+	}
+
+	vector := NewBitVector(0)
+	vector.Grow(length)
+
+	size := order.unitBits()
+	for i := 0; i*size < length; i++ {
+		vector.AppendBits(order.readUnit(b[i*size/8:]), minInt(size, length-i*size))
+	}
+
+	return vector, nil
+}
+
+// Bytes returns the bits as bytes using order, bits past the length are false.
+func (s *BitVector) Bytes(order BitOrder) []byte {
+	return s.AppendBytes(make([]byte, 0, order.byteLength(s.length)), order)
+}
+
+// AppendBytes appends the bits as bytes using order to dst and returns the extended slice.
+func (s *BitVector) AppendBytes(dst []byte, order BitOrder) []byte {
+	if err := order.valid(); err != nil {
+		panic(err)
+	}
+
+	size := order.unitBits()
+	for i := 0; i*size < s.length; i++ {
+		dst = order.appendUnit(dst, s.getBits(i*size, minInt(size, s.length-i*size)))
+	}
+
+	return dst
+}
+
+func (order BitOrder) valid() error {
+	if order&^(MSB0|LittleEndian32|BigEndian32) != 0 || order&(LittleEndian32|BigEndian32) == LittleEndian32|BigEndian32 {
+		return fmt.Errorf("%w: unknown bit order %v", ErrInvalidArgument, int(order))
+	}
+	return nil
+}
+
+// unitBits returns the number of bits read or written at a time, a byte or a word.
+func (order BitOrder) unitBits() int {
+	if order&(LittleEndian32|BigEndian32) != 0 {
+		return bitsPerInt32
+	}
+	return 8
+}
+
+// readUnit reads one byte or word from b, returning bit 0 as the least significant bit.
+func (order BitOrder) readUnit(b []byte) uint64 {
+	switch {
+	case order&LittleEndian32 != 0:
+		return uint64(order.reflect32(binary.LittleEndian.Uint32(b)))
+	case order&BigEndian32 != 0:
+		return uint64(order.reflect32(binary.BigEndian.Uint32(b)))
+	case order&MSB0 != 0:
+		return uint64(bits.Reverse8(b[0]))
+	default:
+		return uint64(b[0])
+	}
+}
+
+// appendUnit is the inverse of readUnit.
+func (order BitOrder) appendUnit(dst []byte, value uint64) []byte {
+	switch {
+	case order&LittleEndian32 != 0:
+		return binary.LittleEndian.AppendUint32(dst, order.reflect32(uint32(value)))
+	case order&BigEndian32 != 0:
+		return binary.BigEndian.AppendUint32(dst, order.reflect32(uint32(value)))
+	case order&MSB0 != 0:
+		return append(dst, bits.Reverse8(byte(value)))
+	default:
+		return append(dst, byte(value))
+	}
+}
+
+func (order BitOrder) reflect32(word uint32) uint32 {
+	if order&MSB0 != 0 {
+		return bits.Reverse32(word)
+	}
+	return word
+}
+
+func (order BitOrder) byteLength(length int) int {
+	size := order.unitBits()
+
+	n, err := getArrayLength(length, size)
+	if err != nil {
+		panic(err)
+	}
+	return n * size / 8
+}
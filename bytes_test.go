@@ -0,0 +1,111 @@
+package bitvector_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitVector_Bytes(t *testing.T) {
+	values := []bool{
+		true, false, false, false, false, false, false, false,
+		true, true, false, false, false, false, false, true,
+		false, true,
+	}
+
+	tests := []struct {
+		name  string
+		order bitvector.BitOrder
+		want  []byte
+	}{
+		{
+			name:  "LSB0",
+			order: bitvector.LSB0,
+			want:  []byte{0x01, 0x83, 0x02},
+		},
+		{
+			name:  "MSB0",
+			order: bitvector.MSB0,
+			want:  []byte{0x80, 0xc1, 0x40},
+		},
+		{
+			name:  "BigEndian32",
+			order: bitvector.BigEndian32,
+			want:  []byte{0x00, 0x02, 0x83, 0x01},
+		},
+		{
+			name:  "BigEndian32 MSB0",
+			order: bitvector.BigEndian32 | bitvector.MSB0,
+			want:  []byte{0x80, 0xc1, 0x40, 0x00},
+		},
+		{
+			name:  "LittleEndian32",
+			order: bitvector.LittleEndian32,
+			want:  []byte{0x01, 0x83, 0x02, 0x00},
+		},
+		{
+			name:  "LittleEndian32 MSB0",
+			order: bitvector.LittleEndian32 | bitvector.MSB0,
+			want:  []byte{0x00, 0x40, 0xc1, 0x80},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bitvector.NewBitVectorFromBool(values)
+
+			got := s.Bytes(tt.order)
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("BitVector.Bytes() = %x, want %x", got, tt.want)
+			}
+
+			if got := s.AppendBytes([]byte{0xff}, tt.order); !bytes.Equal(got, append([]byte{0xff}, tt.want...)) {
+				t.Errorf("BitVector.AppendBytes() = %x, want ff%x", got, tt.want)
+			}
+
+			vector, err := bitvector.FromBytes(tt.want, len(values), tt.order)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !vector.Equal(s) {
+				t.Errorf("FromBytes() = %v, want %v", vector, s)
+			}
+		})
+	}
+}
+
+func TestFromBytes_IgnoresBitsPastLength(t *testing.T) {
+	s, err := bitvector.FromBytes([]byte{0xff, 0xff}, 12, bitvector.MSB0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Length() != 12 || !s.IsFull() {
+		t.Errorf("FromBytes() = %v, want 12 true bits", s)
+	}
+	if got := s.Bytes(bitvector.MSB0); !bytes.Equal(got, []byte{0xff, 0xf0}) {
+		t.Errorf("BitVector.Bytes() = %x, want %x", got, []byte{0xff, 0xf0})
+	}
+
+	if _, err := bitvector.FromBytes([]byte{0xff}, 9, bitvector.LSB0); !errors.Is(err, bitvector.ErrLengthMismatch) {
+		t.Errorf("FromBytes() err = %v, want %v", err, bitvector.ErrLengthMismatch)
+	}
+}
+
+func TestBitOrder_Invalid(t *testing.T) {
+	for _, order := range []bitvector.BitOrder{bitvector.LittleEndian32 | bitvector.BigEndian32, 8} {
+		if _, err := bitvector.FromBytes([]byte{0, 0, 0, 0}, 8, order); !errors.Is(err, bitvector.ErrInvalidArgument) {
+			t.Errorf("FromBytes() err = %v, want %v", err, bitvector.ErrInvalidArgument)
+		}
+
+		func() {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok || !errors.Is(err, bitvector.ErrInvalidArgument) {
+					t.Errorf("BitVector.Bytes() recover() = %v, want %v", err, bitvector.ErrInvalidArgument)
+				}
+			}()
+			bitvector.NewBitVector(8).Bytes(order)
+		}()
+	}
+}
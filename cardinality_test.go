@@ -0,0 +1,134 @@
+package bitvector_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestLinearCounter_Estimate(t *testing.T) {
+	tests := []struct {
+		name  string
+		m     int
+		items int
+	}{
+		{
+			name:  "light load",
+			m:     10000,
+			items: 1000,
+		},
+		{
+			name:  "full load",
+			m:     10000,
+			items: 10000,
+		},
+		{
+			name:  "heavy load",
+			m:     10000,
+			items: 30000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bitvector.NewLinearCounter(tt.m)
+			for i := 0; i < tt.items; i++ {
+				s.Add([]byte(fmt.Sprintf("item-%v", i)))
+				s.Add([]byte(fmt.Sprintf("item-%v", i/2)))
+			}
+
+			got := s.Estimate()
+			if math.Abs(float64(got-tt.items)) > float64(tt.items)*0.05 {
+				t.Errorf("LinearCounter.Estimate() = %v, want ~%v", got, tt.items)
+			}
+		})
+	}
+}
+
+func TestLinearCounter_Merge(t *testing.T) {
+	left := bitvector.NewLinearCounter(10000)
+	right := bitvector.NewLinearCounter(10000)
+	for i := 0; i < 3000; i++ {
+		left.Add([]byte(fmt.Sprintf("item-%v", i)))
+		right.Add([]byte(fmt.Sprintf("item-%v", i+1000)))
+	}
+
+	if err := left.Merge(right); err != nil {
+		t.Fatal(err)
+	}
+	if got := left.Estimate(); math.Abs(float64(got-4000)) > 200 {
+		t.Errorf("LinearCounter.Estimate() = %v, want ~%v", got, 4000)
+	}
+
+	if err := left.Merge(bitvector.NewLinearCounter(10)); !errors.Is(err, bitvector.ErrLengthMismatch) {
+		t.Errorf("LinearCounter.Merge() err = %v, want %v", err, bitvector.ErrLengthMismatch)
+	}
+}
+
+func TestMultiResolutionBitmap_Estimate(t *testing.T) {
+	tests := []struct {
+		name  string
+		items int
+	}{
+		{
+			name:  "small",
+			items: 100,
+		},
+		{
+			name:  "medium",
+			items: 10000,
+		},
+		{
+			name:  "large",
+			items: 300000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bitvector.NewMultiResolutionBitmap(16, 4096)
+			for i := 0; i < tt.items; i++ {
+				s.Add([]byte(fmt.Sprintf("item-%v", i)))
+			}
+
+			got := s.Estimate()
+			if math.Abs(float64(got-tt.items)) > float64(tt.items)*0.1 {
+				t.Errorf("MultiResolutionBitmap.Estimate() = %v, want ~%v", got, tt.items)
+			}
+		})
+	}
+}
+
+func TestMultiResolutionBitmap_Merge(t *testing.T) {
+	left := bitvector.NewMultiResolutionBitmap(16, 4096)
+	right := bitvector.NewMultiResolutionBitmap(16, 4096)
+	for i := 0; i < 50000; i++ {
+		left.Add([]byte(fmt.Sprintf("item-%v", i)))
+		right.Add([]byte(fmt.Sprintf("item-%v", i+25000)))
+	}
+
+	if err := left.Merge(right); err != nil {
+		t.Fatal(err)
+	}
+	if got := left.Estimate(); math.Abs(float64(got-75000)) > 7500 {
+		t.Errorf("MultiResolutionBitmap.Estimate() = %v, want ~%v", got, 75000)
+	}
+}
+
+func TestNewEstimators_Panics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "LinearCounter zero m", fn: func() { bitvector.NewLinearCounter(0) }},
+		{name: "MultiResolutionBitmap zero components", fn: func() { bitvector.NewMultiResolutionBitmap(0, 32) }},
+		{name: "MultiResolutionBitmap zero b", fn: func() { bitvector.NewMultiResolutionBitmap(8, 0) }},
+		{name: "MultiResolutionBitmap too many components", fn: func() { bitvector.NewMultiResolutionBitmap(65, 32) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			panicsWith(t, bitvector.ErrInvalidArgument, tt.fn)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BooleanProduct returns the OR-AND product of s and matrix, bit (i, j) is true
+// when some k has both s(i, k) and matrix(k, j) true. Row i of the result is the
+// OR of the rows of matrix picked out by the true bits of row i of s.
+func (s *BitMatrix) BooleanProduct(matrix *BitMatrix) *BitMatrix {
+	if matrix == nil {
+		panic(ErrNilVector)
+	}
+	if s.cols != matrix.rows {
+		panic(fmt.Errorf("%w: matrix %vx%v can not multiply %vx%v", ErrLengthMismatch, s.rows, s.cols, matrix.rows, matrix.cols))
+	}
+
+	result := NewBitMatrix(s.rows, matrix.cols)
+	for i := 0; i < s.rows; i++ {
+		row := s.Row(i)
+		target := result.Row(i)
+		for w := range row.array {
+			word := row.maskedWord(w)
+			for word != 0 {
+				target.Or(matrix.Row(w*bitsPerInt32 + bits.TrailingZeros32(word)))
+				word &= word - 1
+			}
+		}
+	}
+	return result
+}
+
+// TransitiveClosure returns the transitive closure of a square relation using
+// Warshall's algorithm, bit (i, j) is true when j can be reached from i by one
+// or more steps.
+func (s *BitMatrix) TransitiveClosure() *BitMatrix {
+	s.checkSquare()
+
+	closure := s.Clone()
+	for k := 0; k < closure.rows; k++ {
+		through := closure.Row(k)
+		for i := 0; i < closure.rows; i++ {
+			if closure.Get(i, k) {
+				closure.Row(i).Or(through)
+			}
+		}
+	}
+	return closure
+}
+
+// ReflexiveClosure returns s with every bit on the diagonal set.
+func (s *BitMatrix) ReflexiveClosure() *BitMatrix {
+	s.checkSquare()
+
+	closure := s.Clone()
+	closure.Or(NewIdentityBitMatrix(s.rows))
+	return closure
+}
+
+// SymmetricClosure returns s ORed with its transpose.
+func (s *BitMatrix) SymmetricClosure() *BitMatrix {
+	s.checkSquare()
+
+	closure := s.Clone()
+	closure.Or(s.Transpose())
+	return closure
+}
+
+// ReflexiveTransitiveClosure returns the transitive closure including every node reaching itself.
+func (s *BitMatrix) ReflexiveTransitiveClosure() *BitMatrix {
+	return s.ReflexiveClosure().TransitiveClosure()
+}
+
+func (s *BitMatrix) checkSquare() {
+	if s.rows != s.cols {
+		panic(fmt.Errorf("%w: matrix %vx%v is not square", ErrLengthMismatch, s.rows, s.cols))
+	}
+}
+
+// Reachability answers reachability queries over a directed graph given as an
+// adjacency matrix, where bit (i, j) is an edge from i to j. The transitive
+// closure is computed once so each query is O(1) or one row copy.
+type Reachability struct {
+	closure *BitMatrix
+}
+
+// Allocates a Reachability for the graph with the given square adjacency matrix.
+func NewReachability(adjacency *BitMatrix) *Reachability {
+	if adjacency == nil {
+		panic(ErrNilVector)
+	}
+
+	return &Reachability{
+		closure: adjacency.ReflexiveTransitiveClosure(),
+	}
+}
+
+// Reachable reports whether to can be reached from from, every node reaches itself.
+func (s *Reachability) Reachable(from, to int) bool {
+	return s.closure.Get(from, to)
+}
+
+// ReachableFrom returns the nodes that can be reached from from.
+func (s *Reachability) ReachableFrom(from int) *BitVector {
+	return NewBitVectorFromVector(*s.closure.Row(from))
+}
+
+// ReachableTo returns the nodes that can reach to.
+func (s *Reachability) ReachableTo(to int) *BitVector {
+	return s.closure.Column(to)
+}
+
+// Closure returns the reflexive transitive closure of the graph.
+func (s *Reachability) Closure() *BitMatrix {
+	return s.closure.Clone()
+}
@@ -0,0 +1,96 @@
+package bitvector_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitMatrix_BooleanProduct(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a := randomBitMatrix(r, 20, 45)
+	b := randomBitMatrix(r, 45, 37)
+
+	got := a.BooleanProduct(b)
+	for i := 0; i < a.Rows(); i++ {
+		for j := 0; j < b.Cols(); j++ {
+			want := false
+			for k := 0; k < a.Cols(); k++ {
+				want = want || (a.Get(i, k) && b.Get(k, j))
+			}
+			if got.Get(i, j) != want {
+				t.Fatalf("BitMatrix.BooleanProduct().Get(%v, %v) = %v, want %v", i, j, got.Get(i, j), want)
+			}
+		}
+	}
+}
+
+func TestBitMatrix_TransitiveClosure(t *testing.T) {
+	// 0 -> 1 -> 2 -> 0 cycle, 3 -> 4, 5 alone
+	s := bitvector.NewBitMatrix(6, 6)
+	s.Set(0, 1, true)
+	s.Set(1, 2, true)
+	s.Set(2, 0, true)
+	s.Set(3, 4, true)
+
+	closure := s.TransitiveClosure()
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 6; j++ {
+			want := (i < 3 && j < 3) || (i == 3 && j == 4)
+			if closure.Get(i, j) != want {
+				t.Errorf("BitMatrix.TransitiveClosure().Get(%v, %v) = %v, want %v", i, j, closure.Get(i, j), want)
+			}
+		}
+	}
+
+	// Compare against repeated boolean products on a random graph.
+	r := rand.New(rand.NewSource(2))
+	graph := bitvector.NewBitMatrix(50, 50)
+	for i := 0; i < 60; i++ {
+		graph.Set(r.Intn(50), r.Intn(50), true)
+	}
+	want := graph.Clone()
+	for i := 0; i < 50; i++ {
+		want.Or(want.BooleanProduct(graph))
+	}
+	if !graph.TransitiveClosure().Equal(want) {
+		t.Errorf("BitMatrix.TransitiveClosure() differs from repeated BooleanProduct")
+	}
+}
+
+func TestBitMatrix_Closures(t *testing.T) {
+	s := bitvector.NewBitMatrix(3, 3)
+	s.Set(0, 1, true)
+
+	reflexive := s.ReflexiveClosure()
+	symmetric := s.SymmetricClosure()
+	for i := 0; i < 3; i++ {
+		if !reflexive.Get(i, i) {
+			t.Errorf("BitMatrix.ReflexiveClosure().Get(%v, %v) = false, want true", i, i)
+		}
+	}
+	if !reflexive.Get(0, 1) || reflexive.Get(1, 0) {
+		t.Errorf("BitMatrix.ReflexiveClosure() changed off diagonal bits")
+	}
+	if !symmetric.Get(0, 1) || !symmetric.Get(1, 0) || symmetric.Get(0, 0) {
+		t.Errorf("BitMatrix.SymmetricClosure() = \n%v", symmetric)
+	}
+}
+
+func TestReachability(t *testing.T) {
+	s := bitvector.NewBitMatrix(4, 4)
+	s.Set(0, 1, true)
+	s.Set(1, 2, true)
+
+	reachability := bitvector.NewReachability(s)
+	if !reachability.Reachable(0, 2) || reachability.Reachable(2, 0) || !reachability.Reachable(3, 3) {
+		t.Errorf("Reachability.Reachable() wrong")
+	}
+	if got := reachability.ReachableFrom(0).ToIndices(); len(got) != 3 {
+		t.Errorf("Reachability.ReachableFrom(0) = %v, want [0 1 2]", got)
+	}
+	if got := reachability.ReachableTo(2).ToIndices(); len(got) != 3 {
+		t.Errorf("Reachability.ReachableTo(2) = %v, want [0 1 2]", got)
+	}
+}
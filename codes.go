@@ -0,0 +1,400 @@
+package bitvector
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+)
+
+// IntegerCode is a variable length, prefix free code for unsigned integers.
+type IntegerCode interface {
+	// Encode appends the code for value to w.
+	Encode(w *BitWriter, value uint64) error
+	// Decode reads one value from r, it returns io.EOF if r is already at the end
+	// and io.ErrUnexpectedEOF if r ends part way through a code.
+	Decode(r *BitReader) (uint64, error)
+}
+
+// Unary codes n as n true bits followed by a false bit.
+type Unary struct{}
+
+// EliasGamma codes n >= 1 as len(n)-1 false bits followed by n most significant bit first.
+type EliasGamma struct{}
+
+// EliasDelta codes n >= 1 as the Elias gamma code of len(n) followed by n without its leading bit.
+type EliasDelta struct{}
+
+// EliasOmega codes n >= 1 by recursively prefixing the binary length of n, ending with a false bit.
+type EliasOmega struct{}
+
+// Golomb codes n as the unary quotient n/M followed by the truncated binary remainder n%M.
+type Golomb struct {
+	M uint64
+}
+
+// Rice is Golomb coding with M = 2^K, the remainder is written as K bits.
+type Rice struct {
+	K uint
+}
+
+// Fibonacci codes n >= 1 as its Zeckendorf representation followed by an extra true bit.
+type Fibonacci struct{}
+
+// EncodeAll writes values using code into a new BitVector.
+func EncodeAll(code IntegerCode, values []uint64) (*BitVector, error) {
+	w := NewBitWriter()
+	for _, value := range values {
+		if err := code.Encode(w, value); err != nil {
+			return nil, err
+		}
+	}
+	return w.BitVector(), nil
+}
+
+// DecodeAll reads values using code until the end of vector.
+func DecodeAll(code IntegerCode, vector *BitVector) ([]uint64, error) {
+	values := []uint64{}
+	r := NewBitReader(vector)
+	for {
+		value, err := code.Decode(r)
+		if err == io.EOF {
+			return values, nil
+		}
+		if err != nil {
+			return values, err
+		}
+		values = append(values, value)
+	}
+}
+
+func (Unary) Encode(w *BitWriter, value uint64) error {
+	writeUnary(w, value)
+	return nil
+}
+
+func (Unary) Decode(r *BitReader) (uint64, error) {
+	return readUnary(r)
+}
+
+func (EliasGamma) Encode(w *BitWriter, value uint64) error {
+	if value == 0 {
+		return fmt.Errorf("%w: elias gamma can not code 0", ErrIndexOutOfRange)
+	}
+
+	writeGamma(w, value)
+	return nil
+}
+
+func (EliasGamma) Decode(r *BitReader) (uint64, error) {
+	return readGamma(r)
+}
+
+func (EliasDelta) Encode(w *BitWriter, value uint64) error {
+	if value == 0 {
+		return fmt.Errorf("%w: elias delta can not code 0", ErrIndexOutOfRange)
+	}
+
+	length := bits.Len64(value)
+	writeGamma(w, uint64(length))
+	writeMSB(w, value, length-1)
+	return nil
+}
+
+func (EliasDelta) Decode(r *BitReader) (uint64, error) {
+	length, err := readGamma(r)
+	if err != nil {
+		return 0, err
+	}
+	if length > 64 {
+		return 0, fmt.Errorf("%w: elias delta length %v", ErrInvalidEncoding, length)
+	}
+
+	rest, err := readMSB(r, int(length)-1)
+	if err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	return 1<<(length-1) | rest, nil
+}
+
+func (EliasOmega) Encode(w *BitWriter, value uint64) error {
+	if value == 0 {
+		return fmt.Errorf("%w: elias omega can not code 0", ErrIndexOutOfRange)
+	}
+
+	groups := []uint64{}
+	for value > 1 {
+		groups = append(groups, value)
+		value = uint64(bits.Len64(value) - 1)
+	}
+
+	for i := len(groups) - 1; i >= 0; i-- {
+		writeMSB(w, groups[i], bits.Len64(groups[i]))
+	}
+	w.WriteBit(false)
+	return nil
+}
+
+func (EliasOmega) Decode(r *BitReader) (uint64, error) {
+	value := uint64(1)
+	for first := true; ; first = false {
+		bit, err := r.ReadBit()
+		if err != nil {
+			if first {
+				return 0, err
+			}
+			return 0, unexpectedEOF(err)
+		}
+		if !bit {
+			return value, nil
+		}
+		if value > 63 {
+			return 0, fmt.Errorf("%w: elias omega group of %v bits", ErrInvalidEncoding, value+1)
+		}
+
+		rest, err := readMSB(r, int(value))
+		if err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		value = 1<<value | rest
+	}
+}
+
+func (s Golomb) Encode(w *BitWriter, value uint64) error {
+	if s.M == 0 {
+		return fmt.Errorf("%w: golomb M must be greater than 0", ErrIndexOutOfRange)
+	}
+
+	writeUnary(w, value/s.M)
+
+	remainder := value % s.M
+	width, cutoff := s.truncated()
+	if remainder < cutoff {
+		writeMSB(w, remainder, width-1)
+	} else {
+		writeMSB(w, remainder+cutoff, width)
+	}
+	return nil
+}
+
+func (s Golomb) Decode(r *BitReader) (uint64, error) {
+	if s.M == 0 {
+		return 0, fmt.Errorf("%w: golomb M must be greater than 0", ErrIndexOutOfRange)
+	}
+
+	quotient, err := readUnary(r)
+	if err != nil {
+		return 0, err
+	}
+
+	width, cutoff := s.truncated()
+	remainder := uint64(0)
+	if width > 0 {
+		remainder, err = readMSB(r, width-1)
+		if err != nil {
+			return 0, unexpectedEOF(err)
+		}
+
+		if remainder >= cutoff {
+			bit, err := readMSB(r, 1)
+			if err != nil {
+				return 0, unexpectedEOF(err)
+			}
+			remainder = (remainder<<1 | bit) - cutoff
+		}
+	}
+
+	if quotient > (math.MaxUint64-remainder)/s.M {
+		return 0, fmt.Errorf("%w: golomb quotient %v overflows", ErrInvalidEncoding, quotient)
+	}
+	return quotient*s.M + remainder, nil
+}
+
+// truncated returns the number of bits of the truncated binary remainder and
+// the cutoff below which one bit fewer is used.
+func (s Golomb) truncated() (int, uint64) {
+	if s.M == 1 {
+		return 0, 0
+	}
+
+	// For width 64 the shift wraps to 0, which still gives 2^64 - M.
+	width := bits.Len64(s.M - 1)
+	return width, 1<<width - s.M
+}
+
+func (s Rice) Encode(w *BitWriter, value uint64) error {
+	if s.K > 63 {
+		return fmt.Errorf("%w: rice K must be less than 64", ErrIndexOutOfRange)
+	}
+
+	writeUnary(w, value>>s.K)
+	writeMSB(w, value, int(s.K))
+	return nil
+}
+
+func (s Rice) Decode(r *BitReader) (uint64, error) {
+	if s.K > 63 {
+		return 0, fmt.Errorf("%w: rice K must be less than 64", ErrIndexOutOfRange)
+	}
+
+	quotient, err := readUnary(r)
+	if err != nil {
+		return 0, err
+	}
+
+	remainder, err := readMSB(r, int(s.K))
+	if err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	if quotient > math.MaxUint64>>s.K {
+		return 0, fmt.Errorf("%w: rice quotient %v overflows", ErrInvalidEncoding, quotient)
+	}
+	return quotient<<s.K | remainder, nil
+}
+
+// fibonacci holds the Fibonacci numbers 1, 2, 3, 5, ... that fit in a uint64.
+var fibonacci = func() []uint64 {
+	numbers := []uint64{1, 2}
+	for {
+		a, b := numbers[len(numbers)-2], numbers[len(numbers)-1]
+		if a > math.MaxUint64-b {
+			return numbers
+		}
+		numbers = append(numbers, a+b)
+	}
+}()
+
+func (Fibonacci) Encode(w *BitWriter, value uint64) error {
+	if value == 0 {
+		return fmt.Errorf("%w: fibonacci can not code 0", ErrIndexOutOfRange)
+	}
+
+	top := len(fibonacci) - 1
+	for fibonacci[top] > value {
+		top--
+	}
+
+	digits := make([]bool, top+1)
+	for i := top; i >= 0; i-- {
+		if fibonacci[i] <= value {
+			digits[i] = true
+			value -= fibonacci[i]
+		}
+	}
+
+	for _, digit := range digits {
+		w.WriteBit(digit)
+	}
+	w.WriteBit(true)
+	return nil
+}
+
+func (Fibonacci) Decode(r *BitReader) (uint64, error) {
+	value := uint64(0)
+	previous := false
+	for i := 0; ; i++ {
+		bit, err := r.ReadBit()
+		if err != nil {
+			if i == 0 {
+				return 0, err
+			}
+			return 0, unexpectedEOF(err)
+		}
+		if bit && previous {
+			return value, nil
+		}
+		if bit {
+			if i >= len(fibonacci) || value > math.MaxUint64-fibonacci[i] {
+				return 0, fmt.Errorf("%w: fibonacci code overflows", ErrInvalidEncoding)
+			}
+			value += fibonacci[i]
+		}
+		previous = bit
+	}
+}
+
+func writeUnary(w *BitWriter, value uint64) {
+	for ; value >= 64; value -= 64 {
+		w.write(math.MaxUint64, 64)
+	}
+	// value true bits then a false bit, least significant bit first.
+	w.write(1<<value-1, int(value)+1)
+}
+
+func readUnary(r *BitReader) (uint64, error) {
+	value := uint64(0)
+	for {
+		bit, err := r.ReadBit()
+		if err != nil {
+			if value == 0 {
+				return 0, err
+			}
+			return 0, unexpectedEOF(err)
+		}
+		if !bit {
+			return value, nil
+		}
+		value++
+	}
+}
+
+func writeGamma(w *BitWriter, value uint64) {
+	length := bits.Len64(value)
+	if length > 1 {
+		w.write(0, length-1)
+	}
+	writeMSB(w, value, length)
+}
+
+func readGamma(r *BitReader) (uint64, error) {
+	zeros := 0
+	for {
+		bit, err := r.ReadBit()
+		if err != nil {
+			if zeros == 0 {
+				return 0, err
+			}
+			return 0, unexpectedEOF(err)
+		}
+		if bit {
+			break
+		}
+		zeros++
+		if zeros > 63 {
+			return 0, fmt.Errorf("%w: elias gamma prefix of %v bits", ErrInvalidEncoding, zeros)
+		}
+	}
+
+	rest, err := readMSB(r, zeros)
+	if err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	return 1<<zeros | rest, nil
+}
+
+// writeMSB writes the low n bits of value most significant bit first.
+func writeMSB(w *BitWriter, value uint64, n int) {
+	if n > 0 {
+		w.write(bits.Reverse64(value)>>(64-n), n)
+	}
+}
+
+// readMSB reads n bits written by writeMSB.
+func readMSB(r *BitReader, n int) (uint64, error) {
+	if n == 0 {
+		return 0, nil
+	}
+
+	value, err := r.ReadBits(n)
+	if err != nil {
+		return 0, err
+	}
+	return bits.Reverse64(value) >> (64 - n), nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,185 @@
+package bitvector_test
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestIntegerCode_Bits(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  bitvector.IntegerCode
+		value uint64
+		want  []bool
+	}{
+		{
+			name:  "unary 3",
+			code:  bitvector.Unary{},
+			value: 3,
+			want:  []bool{true, true, true, false},
+		},
+		{
+			name:  "gamma 5",
+			code:  bitvector.EliasGamma{},
+			value: 5,
+			want:  []bool{false, false, true, false, true},
+		},
+		{
+			name:  "delta 10",
+			code:  bitvector.EliasDelta{},
+			value: 10,
+			want:  []bool{false, false, true, false, false, false, true, false},
+		},
+		{
+			name:  "omega 17",
+			code:  bitvector.EliasOmega{},
+			value: 17,
+			want:  []bool{true, false, true, false, false, true, false, false, false, true, false},
+		},
+		{
+			name:  "golomb 9 m 3",
+			code:  bitvector.Golomb{M: 3},
+			value: 9,
+			want:  []bool{true, true, true, false, false},
+		},
+		{
+			name:  "golomb 11 m 3",
+			code:  bitvector.Golomb{M: 3},
+			value: 11,
+			want:  []bool{true, true, true, false, true, true},
+		},
+		{
+			name:  "rice 9 k 2",
+			code:  bitvector.Rice{K: 2},
+			value: 9,
+			want:  []bool{true, true, false, false, true},
+		},
+		{
+			name:  "fibonacci 11",
+			code:  bitvector.Fibonacci{},
+			value: 11,
+			want:  []bool{false, false, true, false, true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bitvector.EncodeAll(tt.code, []uint64{tt.value})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := bitvector.NewBitVectorFromBool(tt.want)
+			if got.String() != want.String() {
+				t.Errorf("EncodeAll() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestIntegerCode_RoundTrip(t *testing.T) {
+	codes := []struct {
+		name string
+		code bitvector.IntegerCode
+		zero bool
+		max  uint64
+	}{
+		{"unary", bitvector.Unary{}, true, 1000},
+		{"gamma", bitvector.EliasGamma{}, false, math.MaxUint64},
+		{"delta", bitvector.EliasDelta{}, false, math.MaxUint64},
+		{"omega", bitvector.EliasOmega{}, false, math.MaxUint64},
+		{"golomb 1", bitvector.Golomb{M: 1}, true, 1000},
+		{"golomb 10", bitvector.Golomb{M: 10}, true, 10000},
+		{"golomb 2^50+3", bitvector.Golomb{M: 1<<50 + 3}, true, math.MaxUint64},
+		{"golomb 2^63+1", bitvector.Golomb{M: 1<<63 + 1}, true, math.MaxUint64},
+		{"rice 0", bitvector.Rice{K: 0}, true, 1000},
+		{"rice 5", bitvector.Rice{K: 5}, true, 30000},
+		{"rice 63", bitvector.Rice{K: 63}, true, math.MaxUint64},
+		{"fibonacci", bitvector.Fibonacci{}, false, math.MaxUint64},
+	}
+	for _, tt := range codes {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+
+			values := []uint64{1, 2, 3, 4, 7, 8, 63, 64, 65, 255, 256}
+			if tt.zero {
+				values = append(values, 0)
+			}
+			for shift := 0; shift < 64; shift++ {
+				for _, v := range []uint64{1<<shift - 1, 1 << shift, 1<<shift + 1} {
+					if v != 0 || tt.zero {
+						values = append(values, v)
+					}
+				}
+			}
+			for i := 0; i < 500; i++ {
+				values = append(values, r.Uint64()>>r.Intn(64)|1)
+			}
+			values = append(values, math.MaxUint64)
+
+			filtered := []uint64{}
+			for _, v := range values {
+				if v <= tt.max {
+					filtered = append(filtered, v)
+				}
+			}
+
+			vector, err := bitvector.EncodeAll(tt.code, filtered)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := bitvector.DecodeAll(tt.code, vector)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, filtered) {
+				t.Errorf("DecodeAll() = %v, want %v", got, filtered)
+			}
+		})
+	}
+}
+
+func TestIntegerCode_Errors(t *testing.T) {
+	for _, code := range []bitvector.IntegerCode{bitvector.EliasGamma{}, bitvector.EliasDelta{}, bitvector.EliasOmega{}, bitvector.Fibonacci{}} {
+		if err := code.Encode(bitvector.NewBitWriter(), 0); !errors.Is(err, bitvector.ErrIndexOutOfRange) {
+			t.Errorf("%T.Encode(0) err = %v, want %v", code, err, bitvector.ErrIndexOutOfRange)
+		}
+	}
+
+	vector, _ := bitvector.EncodeAll(bitvector.EliasGamma{}, []uint64{1000})
+	vector.Resize(vector.Length() - 1)
+	if _, err := bitvector.DecodeAll(bitvector.EliasGamma{}, vector); err != io.ErrUnexpectedEOF {
+		t.Errorf("DecodeAll() err = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+
+	// Crafted streams whose unary quotient overflows the decoded value.
+	tests := []struct {
+		name     string
+		code     bitvector.IntegerCode
+		quotient int
+		zeros    int
+	}{
+		{name: "Rice", code: bitvector.Rice{K: 63}, quotient: 2, zeros: 63},
+		{name: "Golomb", code: bitvector.Golomb{M: 1<<62 + 1}, quotient: 5, zeros: 62},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := bitvector.NewBitWriter()
+			for i := 0; i < tt.quotient; i++ {
+				w.WriteBit(true)
+			}
+			w.WriteBit(false)
+			for i := 0; i < tt.zeros; i++ {
+				w.WriteBit(false)
+			}
+
+			if got, err := tt.code.Decode(bitvector.NewBitReader(w.BitVector())); !errors.Is(err, bitvector.ErrInvalidEncoding) {
+				t.Errorf("%T.Decode() = %v, %v, want %v", tt.code, got, err, bitvector.ErrInvalidEncoding)
+			}
+		})
+	}
+}
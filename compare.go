@@ -0,0 +1,139 @@
+package bitvector
+
+import "fmt"
+
+// Equal reports whether s and vector have the same length and bit values.
+func (s *BitVector) Equal(vector *BitVector) bool {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+	if s.Length() != vector.Length() {
+		return false
+	}
+
+	arrayLength := s.words()
+	for i := 0; i < arrayLength; i++ {
+		if s.maskedWord(i) != vector.maskedWord(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSubsetOf reports whether every true bit of s is also true in vector.
+func (s *BitVector) IsSubsetOf(vector *BitVector) bool {
+	arrayLength := s.relation(vector)
+	for i := 0; i < arrayLength; i++ {
+		if s.maskedWord(i)&^vector.maskedWord(i) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf reports whether every true bit of vector is also true in s.
+func (s *BitVector) IsSupersetOf(vector *BitVector) bool {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+	return vector.IsSubsetOf(s)
+}
+
+// Intersects reports whether s and vector have a true bit in common.
+func (s *BitVector) Intersects(vector *BitVector) bool {
+	arrayLength := s.relation(vector)
+	for i := 0; i < arrayLength; i++ {
+		if s.maskedWord(i)&vector.maskedWord(i) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDisjoint reports whether s and vector have no true bit in common.
+func (s *BitVector) IsDisjoint(vector *BitVector) bool {
+	return !s.Intersects(vector)
+}
+
+// IsEmpty reports whether every bit is false.
+func (s *BitVector) IsEmpty() bool {
+	arrayLength := s.words()
+	for i := 0; i < arrayLength; i++ {
+		if s.maskedWord(i) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsFull reports whether every bit is true.
+func (s *BitVector) IsFull() bool {
+	arrayLength := s.words()
+	for i := 0; i < arrayLength; i++ {
+		full := uint32(0xffffffff)
+		if rest := s.length - i*bitsPerInt32; rest < bitsPerInt32 {
+			full = (1 << rest) - 1
+		}
+		if s.maskedWord(i) != full {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare orders a and b lexicographically by bit value from index 0, with false
+// before true and a shorter vector before any longer vector it is a prefix of.
+// It returns -1, 0 or +1 and can be used with slices.SortFunc.
+func Compare(a, b *BitVector) int {
+	if a == nil || b == nil {
+		panic(ErrNilVector)
+	}
+
+	length := a.Length()
+	if b.Length() < length {
+		length = b.Length()
+	}
+
+	for i := 0; i*bitsPerInt32 < length; i++ {
+		x, y := a.array[i], b.array[i]
+		if rest := length - i*bitsPerInt32; rest < bitsPerInt32 {
+			x &= (1 << rest) - 1
+			y &= (1 << rest) - 1
+		}
+
+		if diff := x ^ y; diff != 0 {
+			// The lowest differing bit is the first in index order.
+			if x&diff&-diff != 0 {
+				return 1
+			}
+			return -1
+		}
+	}
+
+	switch {
+	case a.Length() < b.Length():
+		return -1
+	case a.Length() > b.Length():
+		return 1
+	}
+	return 0
+}
+
+// words returns the number of array words in use.
+func (s *BitVector) words() int {
+	arrayLength, err := getArrayLength(s.length, bitsPerInt32)
+	if err != nil {
+		panic(err)
+	}
+	return arrayLength
+}
+
+func (s *BitVector) relation(vector *BitVector) int {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+	if s.Length() != vector.Length() {
+		panic(fmt.Errorf("%w: vector length is different", ErrLengthMismatch))
+	}
+	return s.words()
+}
@@ -0,0 +1,171 @@
+package bitvector_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitVector_Relations(t *testing.T) {
+	tests := []struct {
+		name       string
+		left       []bool
+		right      []bool
+		equal      bool
+		subset     bool
+		superset   bool
+		intersects bool
+	}{
+		{
+			name:       "equal",
+			left:       []bool{true, false, true},
+			right:      []bool{true, false, true},
+			equal:      true,
+			subset:     true,
+			superset:   true,
+			intersects: true,
+		},
+		{
+			name:       "subset",
+			left:       []bool{true, false, false},
+			right:      []bool{true, false, true},
+			subset:     true,
+			intersects: true,
+		},
+		{
+			name:       "superset",
+			left:       []bool{true, true, true},
+			right:      []bool{false, false, true},
+			superset:   true,
+			intersects: true,
+		},
+		{
+			name:  "disjoint",
+			left:  []bool{true, false, false},
+			right: []bool{false, true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left := bitvector.NewBitVectorFromBool(tt.left)
+			right := bitvector.NewBitVectorFromBool(tt.right)
+
+			if got := left.Equal(right); got != tt.equal {
+				t.Errorf("BitVector.Equal() = %v, want %v", got, tt.equal)
+			}
+			if got := left.IsSubsetOf(right); got != tt.subset {
+				t.Errorf("BitVector.IsSubsetOf() = %v, want %v", got, tt.subset)
+			}
+			if got := left.IsSupersetOf(right); got != tt.superset {
+				t.Errorf("BitVector.IsSupersetOf() = %v, want %v", got, tt.superset)
+			}
+			if got := left.Intersects(right); got != tt.intersects {
+				t.Errorf("BitVector.Intersects() = %v, want %v", got, tt.intersects)
+			}
+			if got := left.IsDisjoint(right); got == tt.intersects {
+				t.Errorf("BitVector.IsDisjoint() = %v, want %v", got, !tt.intersects)
+			}
+		})
+	}
+}
+
+func TestBitVector_Relations_IgnoreBitsPastLength(t *testing.T) {
+	full := bitvector.NewBitVectorOfLength(40, true)
+	empty := bitvector.NewBitVectorOfLength(40, true)
+	empty.Not()
+	notEmpty := bitvector.NewBitVector(40)
+	notEmpty.Not()
+
+	if !full.IsFull() || !notEmpty.IsFull() {
+		t.Errorf("BitVector.IsFull() = false, want true")
+	}
+	if !empty.IsEmpty() {
+		t.Errorf("BitVector.IsEmpty() = false, want true")
+	}
+	if !full.Equal(notEmpty) {
+		t.Errorf("BitVector.Equal() = false, want true")
+	}
+	if !full.IsSubsetOf(notEmpty) {
+		t.Errorf("BitVector.IsSubsetOf() = false, want true")
+	}
+	if empty.Intersects(empty) {
+		t.Errorf("BitVector.Intersects() = true, want false")
+	}
+	if got := bitvector.Compare(full, notEmpty); got != 0 {
+		t.Errorf("Compare() = %v, want %v", got, 0)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  []bool
+		right []bool
+		want  int
+	}{
+		{
+			name:  "equal",
+			left:  []bool{true, false},
+			right: []bool{true, false},
+			want:  0,
+		},
+		{
+			name:  "first bit",
+			left:  []bool{false, true},
+			right: []bool{true, false},
+			want:  -1,
+		},
+		{
+			name:  "later bit",
+			left:  []bool{true, true, false},
+			right: []bool{true, false, true},
+			want:  1,
+		},
+		{
+			name:  "prefix",
+			left:  []bool{true, false},
+			right: []bool{true, false, false},
+			want:  -1,
+		},
+		{
+			name:  "over array bounds",
+			left:  append(make([]bool, 40), true),
+			right: append(make([]bool, 40), false),
+			want:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left := bitvector.NewBitVectorFromBool(tt.left)
+			right := bitvector.NewBitVectorFromBool(tt.right)
+
+			if got := bitvector.Compare(left, right); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+			if got := bitvector.Compare(right, left); got != -tt.want {
+				t.Errorf("Compare() = %v, want %v", got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare_Sort(t *testing.T) {
+	vectors := []*bitvector.BitVector{
+		bitvector.NewBitVectorFromBool([]bool{true, true}),
+		bitvector.NewBitVectorFromBool([]bool{false}),
+		bitvector.NewBitVectorFromBool([]bool{true}),
+		bitvector.NewBitVectorFromBool([]bool{false, true}),
+		bitvector.NewBitVectorFromBool([]bool{}),
+	}
+	want := []string{"{  }\n", "{ false }\n", "{ false, true }\n", "{ true }\n", "{ true, true }\n"}
+
+	sort.Slice(vectors, func(i, j int) bool {
+		return bitvector.Compare(vectors[i], vectors[j]) < 0
+	})
+	for i, v := range vectors {
+		if v.String() != want[i] {
+			t.Errorf("sorted[%v] = %v, want %v", i, v, want[i])
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package bitvector
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	bitsPerCounter = 4
+	countersPerInt = bitsPerInt32 / bitsPerCounter
+	counterMax     = 1<<bitsPerCounter - 1
+)
+
+// CountingBloomFilter is a Bloom filter with a 4 bit counter per position so
+// items can be removed. Counters saturate at 15, a saturated counter is never
+// decremented so items sharing it can not become false negatives.
+type CountingBloomFilter struct {
+	counters *BitVector
+	m        uint64
+	k        uint64
+}
+
+// Allocates a CountingBloomFilter sized to hold expectedItems with a false positive rate of falsePositiveRate.
+func NewCountingBloomFilter(expectedItems int, falsePositiveRate float64) *CountingBloomFilter {
+	if expectedItems <= 0 {
+		panic(fmt.Errorf("%w: expectedItems must be greater than 0", ErrInvalidArgument))
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		panic(fmt.Errorf("%w: falsePositiveRate must be between 0 and 1", ErrInvalidArgument))
+	}
+
+	n := float64(expectedItems)
+	m := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/n*math.Ln2))
+
+	return NewCountingBloomFilterOfSize(int(m), int(k))
+}
+
+// Allocates a CountingBloomFilter of m counters using k hash functions.
+func NewCountingBloomFilterOfSize(m, k int) *CountingBloomFilter {
+	if m <= 0 || k <= 0 {
+		panic(fmt.Errorf("%w: m and k must be greater than 0", ErrInvalidArgument))
+	}
+
+	return &CountingBloomFilter{
+		counters: NewBitVector(m * bitsPerCounter),
+		m:        uint64(m),
+		k:        uint64(k),
+	}
+}
+
+// M returns the number of counters in the filter.
+func (s *CountingBloomFilter) M() int {
+	return int(s.m)
+}
+
+// K returns the number of hash functions used per item.
+func (s *CountingBloomFilter) K() int {
+	return int(s.k)
+}
+
+// Add inserts data into the filter.
+func (s *CountingBloomFilter) Add(data []byte) {
+	h1, h2 := bloomHash(data)
+	for i := uint64(0); i < s.k; i++ {
+		index := (h1 + i*h2) % s.m
+		if count := s.counter(index); count < counterMax {
+			s.setCounter(index, count+1)
+		}
+	}
+	s.counters.version++
+}
+
+// Remove deletes data from the filter, it returns false and leaves the filter
+// unchanged if data is not in the filter.
+func (s *CountingBloomFilter) Remove(data []byte) bool {
+	if !s.Test(data) {
+		return false
+	}
+
+	h1, h2 := bloomHash(data)
+	for i := uint64(0); i < s.k; i++ {
+		index := (h1 + i*h2) % s.m
+		if count := s.counter(index); count > 0 && count < counterMax {
+			s.setCounter(index, count-1)
+		}
+	}
+	s.counters.version++
+	return true
+}
+
+// Test reports whether data may be in the filter.
+func (s *CountingBloomFilter) Test(data []byte) bool {
+	h1, h2 := bloomHash(data)
+	for i := uint64(0); i < s.k; i++ {
+		if s.counter((h1+i*h2)%s.m) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Saturated returns the number of counters that have reached their maximum.
+func (s *CountingBloomFilter) Saturated() int {
+	saturated := 0
+	for i := uint64(0); i < s.m; i++ {
+		if s.counter(i) == counterMax {
+			saturated++
+		}
+	}
+	return saturated
+}
+
+// BloomFilter returns a plain BloomFilter with a bit set for every non zero
+// counter, it answers Test the same as s.
+func (s *CountingBloomFilter) BloomFilter() *BloomFilter {
+	filter := NewBloomFilterOfSize(int(s.m), int(s.k))
+	for i := uint64(0); i < s.m; i++ {
+		if s.counter(i) != 0 {
+			filter.vector.array[i/bitsPerInt32] |= 1 << (i % bitsPerInt32)
+		}
+	}
+	return filter
+}
+
+func (s *CountingBloomFilter) counter(index uint64) uint32 {
+	shift := (index % countersPerInt) * bitsPerCounter
+	return (s.counters.array[index/countersPerInt] >> shift) & counterMax
+}
+
+func (s *CountingBloomFilter) setCounter(index uint64, count uint32) {
+	shift := (index % countersPerInt) * bitsPerCounter
+	word := &s.counters.array[index/countersPerInt]
+	*word = (*word &^ (counterMax << shift)) | (count << shift)
+}
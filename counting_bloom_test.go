@@ -0,0 +1,92 @@
+package bitvector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestCountingBloomFilter_Add_Remove(t *testing.T) {
+	s := bitvector.NewCountingBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		s.Add([]byte(fmt.Sprintf("item-%v", i)))
+	}
+
+	for i := 0; i < 1000; i++ {
+		if !s.Test([]byte(fmt.Sprintf("item-%v", i))) {
+			t.Fatalf("CountingBloomFilter.Test(item-%v) = false, want true", i)
+		}
+	}
+
+	for i := 0; i < 500; i++ {
+		if !s.Remove([]byte(fmt.Sprintf("item-%v", i))) {
+			t.Fatalf("CountingBloomFilter.Remove(item-%v) = false, want true", i)
+		}
+	}
+
+	for i := 500; i < 1000; i++ {
+		if !s.Test([]byte(fmt.Sprintf("item-%v", i))) {
+			t.Fatalf("CountingBloomFilter.Test(item-%v) = false, want true", i)
+		}
+	}
+
+	present := 0
+	for i := 0; i < 500; i++ {
+		if s.Test([]byte(fmt.Sprintf("item-%v", i))) {
+			present++
+		}
+	}
+	if present > 25 {
+		t.Errorf("removed items still present = %v, want <= 25", present)
+	}
+}
+
+func TestCountingBloomFilter_Saturation(t *testing.T) {
+	s := bitvector.NewCountingBloomFilterOfSize(64, 3)
+	for i := 0; i < 20; i++ {
+		s.Add([]byte("a"))
+	}
+	if got := s.Saturated(); got == 0 {
+		t.Errorf("CountingBloomFilter.Saturated() = %v, want > 0", got)
+	}
+
+	for i := 0; i < 20; i++ {
+		s.Remove([]byte("a"))
+	}
+	if !s.Test([]byte("a")) {
+		t.Errorf("CountingBloomFilter.Test() after saturation = false, want true")
+	}
+}
+
+func TestCountingBloomFilter_BloomFilter(t *testing.T) {
+	s := bitvector.NewCountingBloomFilter(100, 0.01)
+	for i := 0; i < 100; i++ {
+		s.Add([]byte(fmt.Sprintf("item-%v", i)))
+	}
+
+	filter := s.BloomFilter()
+	for i := 0; i < 1000; i++ {
+		item := []byte(fmt.Sprintf("item-%v", i))
+		if got, want := filter.Test(item), s.Test(item); got != want {
+			t.Fatalf("BloomFilter.Test(item-%v) = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestNewCountingBloomFilter_Panics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "no items", fn: func() { bitvector.NewCountingBloomFilter(0, 0.01) }},
+		{name: "zero rate", fn: func() { bitvector.NewCountingBloomFilter(100, 0) }},
+		{name: "zero m", fn: func() { bitvector.NewCountingBloomFilterOfSize(0, 3) }},
+		{name: "zero k", fn: func() { bitvector.NewCountingBloomFilterOfSize(64, 0) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			panicsWith(t, bitvector.ErrInvalidArgument, tt.fn)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// CRCParams describes a CRC in the Rocksoft model used by the CRC catalogue.
+type CRCParams struct {
+	// Name is only used for display.
+	Name string
+	// Width is the number of bits in the CRC, between 1 and 64.
+	Width int
+	// Poly is the generator polynomial without its x^Width term, most significant bit first.
+	Poly uint64
+	// Init is the register value before any data, unreflected.
+	Init uint64
+	// RefIn processes each byte least significant bit first.
+	RefIn bool
+	// RefOut reflects the register before XorOut is applied.
+	RefOut bool
+	// XorOut is XORed with the register to give the CRC.
+	XorOut uint64
+}
+
+// Parameters of common CRCs from the CRC catalogue.
+var (
+	CRC3GSM      = CRCParams{Name: "CRC-3/GSM", Width: 3, Poly: 0x3, XorOut: 0x7}
+	CRC5USB      = CRCParams{Name: "CRC-5/USB", Width: 5, Poly: 0x05, Init: 0x1f, RefIn: true, RefOut: true, XorOut: 0x1f}
+	CRC8SMBus    = CRCParams{Name: "CRC-8/SMBUS", Width: 8, Poly: 0x07}
+	CRC12UMTS    = CRCParams{Name: "CRC-12/UMTS", Width: 12, Poly: 0x80f, RefOut: true}
+	CRC16ARC     = CRCParams{Name: "CRC-16/ARC", Width: 16, Poly: 0x8005, RefIn: true, RefOut: true}
+	CRC16IBM3740 = CRCParams{Name: "CRC-16/IBM-3740", Width: 16, Poly: 0x1021, Init: 0xffff}
+	CRC16Kermit  = CRCParams{Name: "CRC-16/KERMIT", Width: 16, Poly: 0x1021, RefIn: true, RefOut: true}
+	CRC32ISOHDLC = CRCParams{Name: "CRC-32/ISO-HDLC", Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff}
+	CRC32C       = CRCParams{Name: "CRC-32/ISCSI", Width: 32, Poly: 0x1edc6f41, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff}
+	CRC32BZip2   = CRCParams{Name: "CRC-32/BZIP2", Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, XorOut: 0xffffffff}
+	CRC64ECMA182 = CRCParams{Name: "CRC-64/ECMA-182", Width: 64, Poly: 0x42f0e1eba9ea3693}
+	CRC64XZ      = CRCParams{Name: "CRC-64/XZ", Width: 64, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff}
+)
+
+// CRC computes a cyclic redundancy check described by CRCParams, either a byte
+// at a time from a 256 entry table or a bit at a time.
+//
+// Non reflected CRCs keep the register in the top Width bits of a uint64 and
+// reflected CRCs keep it reflected in the bottom Width bits, so every width
+// shares the same shift and XOR steps.
+type CRC struct {
+	params CRCParams
+	poly   uint64
+	init   uint64
+	table  [256]uint64
+}
+
+// Allocates a CRC for params.
+func NewCRC(params CRCParams) *CRC {
+	if params.Width < 1 || params.Width > 64 {
+		panic(fmt.Errorf("%w: width %v must be between 1 and 64", ErrIndexOutOfRange, params.Width))
+	}
+
+	s := &CRC{
+		params: params,
+	}
+
+	mask := s.mask()
+	if params.RefIn {
+		s.poly = reflect(params.Poly&mask, params.Width)
+		s.init = reflect(params.Init&mask, params.Width)
+	} else {
+		s.poly = (params.Poly & mask) << (64 - params.Width)
+		s.init = (params.Init & mask) << (64 - params.Width)
+	}
+
+	for i := range s.table {
+		if params.RefIn {
+			s.table[i] = s.shift(uint64(i), 8)
+		} else {
+			s.table[i] = s.shift(uint64(i)<<56, 8)
+		}
+	}
+
+	return s
+}
+
+// Params returns the parameters of the CRC.
+func (s *CRC) Params() CRCParams {
+	return s.params
+}
+
+// Polynomial returns the generator polynomial including its x^Width term.
+func (s *CRC) Polynomial() *Polynomial {
+	return NewPolynomialFromUint64(s.params.Poly & s.mask()).Add(NewPolynomialFromExponents(s.params.Width))
+}
+
+// Checksum returns the CRC of data using the table.
+func (s *CRC) Checksum(data []byte) uint64 {
+	register := s.init
+	for _, b := range data {
+		if s.params.RefIn {
+			register = register>>8 ^ s.table[byte(register)^b]
+		} else {
+			register = register<<8 ^ s.table[byte(register>>56)^b]
+		}
+	}
+	return s.finish(register)
+}
+
+// ChecksumBitwise returns the CRC of data one bit at a time without the table.
+func (s *CRC) ChecksumBitwise(data []byte) uint64 {
+	register := s.init
+	for _, b := range data {
+		if s.params.RefIn {
+			register = s.shift(register^uint64(b), 8)
+		} else {
+			register = s.shift(register^uint64(b)<<56, 8)
+		}
+	}
+	return s.finish(register)
+}
+
+// ChecksumBits returns the CRC of the bits of vector, taken in index order. For a
+// whole number of bytes it matches Checksum of the bytes from vector.Bytes(MSB0),
+// or vector.Bytes(LSB0) when RefIn is set.
+func (s *CRC) ChecksumBits(vector *BitVector) uint64 {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+
+	register := s.init
+	for i := 0; i < vector.Length(); i++ {
+		bit := uint64(0)
+		if vector.Get(i) {
+			bit = 1
+		}
+
+		if s.params.RefIn {
+			register = s.shift(register^bit, 1)
+		} else {
+			register = s.shift(register^bit<<63, 1)
+		}
+	}
+	return s.finish(register)
+}
+
+// shift clocks the register n times.
+func (s *CRC) shift(register uint64, n int) uint64 {
+	for i := 0; i < n; i++ {
+		if s.params.RefIn {
+			if register&1 == 1 {
+				register = register>>1 ^ s.poly
+			} else {
+				register >>= 1
+			}
+		} else {
+			if register>>63 == 1 {
+				register = register<<1 ^ s.poly
+			} else {
+				register <<= 1
+			}
+		}
+	}
+	return register
+}
+
+func (s *CRC) finish(register uint64) uint64 {
+	if !s.params.RefIn {
+		register >>= 64 - s.params.Width
+	}
+	if s.params.RefIn != s.params.RefOut {
+		register = reflect(register, s.params.Width)
+	}
+	return (register ^ s.params.XorOut) & s.mask()
+}
+
+func (s *CRC) mask() uint64 {
+	return ^uint64(0) >> (64 - s.params.Width)
+}
+
+// reflect reverses the low width bits of value.
+func reflect(value uint64, width int) uint64 {
+	return bits.Reverse64(value) >> (64 - width)
+}
@@ -0,0 +1,127 @@
+package bitvector_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+var crcCatalogue = []struct {
+	params bitvector.CRCParams
+	check  uint64
+}{
+	{params: bitvector.CRC3GSM, check: 0x4},
+	{params: bitvector.CRC5USB, check: 0x19},
+	{params: bitvector.CRC8SMBus, check: 0xf4},
+	{params: bitvector.CRC12UMTS, check: 0xdaf},
+	{params: bitvector.CRC16ARC, check: 0xbb3d},
+	{params: bitvector.CRC16IBM3740, check: 0x29b1},
+	{params: bitvector.CRC16Kermit, check: 0x2189},
+	{params: bitvector.CRC32ISOHDLC, check: 0xcbf43926},
+	{params: bitvector.CRC32C, check: 0xe3069283},
+	{params: bitvector.CRC32BZip2, check: 0xfc891918},
+	{params: bitvector.CRC64ECMA182, check: 0x6c40df5f0b497347},
+	{params: bitvector.CRC64XZ, check: 0x995dc9bbdf1939fa},
+}
+
+func TestCRC_Checksum(t *testing.T) {
+	data := []byte("123456789")
+	for _, tt := range crcCatalogue {
+		t.Run(tt.params.Name, func(t *testing.T) {
+			crc := bitvector.NewCRC(tt.params)
+			if got := crc.Checksum(data); got != tt.check {
+				t.Errorf("CRC.Checksum() = %#x, want %#x", got, tt.check)
+			}
+			if got := crc.ChecksumBitwise(data); got != tt.check {
+				t.Errorf("CRC.ChecksumBitwise() = %#x, want %#x", got, tt.check)
+			}
+		})
+	}
+}
+
+func TestCRC_ChecksumBitwise(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, tt := range crcCatalogue {
+		t.Run(tt.params.Name, func(t *testing.T) {
+			crc := bitvector.NewCRC(tt.params)
+			for i := 0; i < 20; i++ {
+				data := make([]byte, r.Intn(100))
+				r.Read(data)
+
+				if got, want := crc.ChecksumBitwise(data), crc.Checksum(data); got != want {
+					t.Fatalf("CRC.ChecksumBitwise() = %#x, want %#x", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCRC_ChecksumBits(t *testing.T) {
+	data := []byte("123456789")
+	for _, tt := range crcCatalogue {
+		t.Run(tt.params.Name, func(t *testing.T) {
+			order := bitvector.MSB0
+			if tt.params.RefIn {
+				order = bitvector.LSB0
+			}
+			vector, err := bitvector.FromBytes(data, len(data)*8, order)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := bitvector.NewCRC(tt.params).ChecksumBits(vector); got != tt.check {
+				t.Errorf("CRC.ChecksumBits() = %#x, want %#x", got, tt.check)
+			}
+		})
+	}
+}
+
+func TestCRC_Polynomial(t *testing.T) {
+	params := bitvector.CRCParams{Width: 16, Poly: 0x1021}
+	crc := bitvector.NewCRC(params)
+
+	want := bitvector.NewPolynomialFromExponents(16, 12, 5, 0)
+	if got := crc.Polynomial(); !got.Equal(want) {
+		t.Fatalf("CRC.Polynomial() = %v, want %v", got, want)
+	}
+
+	// With no init, reflection or xor out the CRC is the message times x^16 mod G.
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 20; i++ {
+		vector := randomBitVector(r, 1+r.Intn(300))
+
+		message := bitvector.NewBitVector(vector.Length())
+		for j := 0; j < vector.Length(); j++ {
+			message.Set(vector.Length()-1-j, vector.Get(j))
+		}
+		remainder := bitvector.NewPolynomial(message).Mul(bitvector.NewPolynomialFromExponents(16)).Mod(want)
+
+		got := bitvector.NewPolynomialFromUint64(crc.ChecksumBits(vector))
+		if !got.Equal(remainder) {
+			t.Fatalf("CRC.ChecksumBits() = %v, want %v", got, remainder)
+		}
+	}
+}
+
+func TestNewCRC_Panics(t *testing.T) {
+	for _, width := range []int{0, 65} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("NewCRC() width %v did not panic", width)
+				}
+			}()
+			bitvector.NewCRC(bitvector.CRCParams{Width: width, Poly: 1})
+		}()
+	}
+}
+
+func BenchmarkCRC_Checksum(b *testing.B) {
+	data := make([]byte, 4096)
+	crc := bitvector.NewCRC(bitvector.CRC32ISOHDLC)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		crc.Checksum(data)
+	}
+}
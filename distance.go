@@ -0,0 +1,94 @@
+package bitvector
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// HammingDistance returns the number of positions where a and b differ.
+func HammingDistance(a, b *BitVector) int {
+	onesA, onesB, both := countPair(a, b)
+	return onesA + onesB - 2*both
+}
+
+// IntersectionCount returns the number of positions that are true in both a and b.
+func IntersectionCount(a, b *BitVector) int {
+	_, _, both := countPair(a, b)
+	return both
+}
+
+// UnionCount returns the number of positions that are true in either a or b.
+func UnionCount(a, b *BitVector) int {
+	onesA, onesB, both := countPair(a, b)
+	return onesA + onesB - both
+}
+
+// Jaccard returns |a ∩ b| / |a ∪ b|, two empty sets are identical and return 1.
+func Jaccard(a, b *BitVector) float64 {
+	onesA, onesB, both := countPair(a, b)
+	union := onesA + onesB - both
+	if union == 0 {
+		return 1
+	}
+	return float64(both) / float64(union)
+}
+
+// Tanimoto returns the Tanimoto similarity, which for bitvectors is the same as Jaccard.
+func Tanimoto(a, b *BitVector) float64 {
+	return Jaccard(a, b)
+}
+
+// Dice returns 2|a ∩ b| / (|a| + |b|), two empty sets are identical and return 1.
+func Dice(a, b *BitVector) float64 {
+	onesA, onesB, both := countPair(a, b)
+	if onesA+onesB == 0 {
+		return 1
+	}
+	return 2 * float64(both) / float64(onesA+onesB)
+}
+
+// Cosine returns |a ∩ b| / sqrt(|a| |b|), two empty sets return 1 and one empty set returns 0.
+func Cosine(a, b *BitVector) float64 {
+	onesA, onesB, both := countPair(a, b)
+	if onesA == 0 && onesB == 0 {
+		return 1
+	}
+	if onesA == 0 || onesB == 0 {
+		return 0
+	}
+	return float64(both) / math.Sqrt(float64(onesA)*float64(onesB))
+}
+
+// countPair returns the true bits in a, in b and in both, ignoring any bits past the length.
+func countPair(a, b *BitVector) (int, int, int) {
+	if a == nil || b == nil {
+		panic(ErrNilVector)
+	}
+	if a.Length() != b.Length() {
+		panic(fmt.Errorf("%w: vector length is different", ErrLengthMismatch))
+	}
+
+	arrayLength, err := getArrayLength(a.length, bitsPerInt32)
+	if err != nil {
+		panic(err)
+	}
+	if arrayLength == 0 {
+		return 0, 0, 0
+	}
+
+	onesA, onesB, both := 0, 0, 0
+	for i := 0; i < arrayLength-1; i++ {
+		x, y := a.array[i], b.array[i]
+		onesA += bits.OnesCount32(x)
+		onesB += bits.OnesCount32(y)
+		both += bits.OnesCount32(x & y)
+	}
+
+	x, y := a.maskedWord(arrayLength-1), b.maskedWord(arrayLength-1)
+	onesA += bits.OnesCount32(x)
+	onesB += bits.OnesCount32(y)
+	both += bits.OnesCount32(x & y)
+
+	return onesA, onesB, both
+}
@@ -0,0 +1,111 @@
+package bitvector_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name         string
+		left         []bool
+		right        []bool
+		hamming      int
+		intersection int
+		union        int
+		jaccard      float64
+		dice         float64
+		cosine       float64
+	}{
+		{
+			name:         "distance",
+			left:         []bool{false, false, true, true},
+			right:        []bool{false, true, false, true},
+			hamming:      2,
+			intersection: 1,
+			union:        3,
+			jaccard:      1.0 / 3,
+			dice:         0.5,
+			cosine:       0.5,
+		},
+		{
+			name:         "empty",
+			left:         []bool{false, false},
+			right:        []bool{false, false},
+			hamming:      0,
+			intersection: 0,
+			union:        0,
+			jaccard:      1,
+			dice:         1,
+			cosine:       1,
+		},
+		{
+			name:         "disjoint",
+			left:         []bool{true, false, true},
+			right:        []bool{false, true, false},
+			hamming:      3,
+			intersection: 0,
+			union:        3,
+			jaccard:      0,
+			dice:         0,
+			cosine:       0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left := bitvector.NewBitVectorFromBool(tt.left)
+			right := bitvector.NewBitVectorFromBool(tt.right)
+
+			if got := bitvector.HammingDistance(left, right); got != tt.hamming {
+				t.Errorf("HammingDistance() = %v, want %v", got, tt.hamming)
+			}
+			if got := bitvector.IntersectionCount(left, right); got != tt.intersection {
+				t.Errorf("IntersectionCount() = %v, want %v", got, tt.intersection)
+			}
+			if got := bitvector.UnionCount(left, right); got != tt.union {
+				t.Errorf("UnionCount() = %v, want %v", got, tt.union)
+			}
+			if got := bitvector.Jaccard(left, right); math.Abs(got-tt.jaccard) > 1e-9 {
+				t.Errorf("Jaccard() = %v, want %v", got, tt.jaccard)
+			}
+			if got := bitvector.Tanimoto(left, right); math.Abs(got-tt.jaccard) > 1e-9 {
+				t.Errorf("Tanimoto() = %v, want %v", got, tt.jaccard)
+			}
+			if got := bitvector.Dice(left, right); math.Abs(got-tt.dice) > 1e-9 {
+				t.Errorf("Dice() = %v, want %v", got, tt.dice)
+			}
+			if got := bitvector.Cosine(left, right); math.Abs(got-tt.cosine) > 1e-9 {
+				t.Errorf("Cosine() = %v, want %v", got, tt.cosine)
+			}
+		})
+	}
+}
+
+func TestDistance_IgnoresBitsPastLength(t *testing.T) {
+	left := bitvector.NewBitVectorOfLength(40, true)
+	right := bitvector.NewBitVectorOfLength(40, false)
+	right.Not()
+
+	if got := bitvector.HammingDistance(left, right); got != 0 {
+		t.Errorf("HammingDistance() = %v, want %v", got, 0)
+	}
+	if got := bitvector.UnionCount(left, right); got != 40 {
+		t.Errorf("UnionCount() = %v, want %v", got, 40)
+	}
+}
+
+func TestDistance_DoesNotAllocate(t *testing.T) {
+	left := bitvector.NewBitVectorOfLength(1000, true)
+	right := bitvector.NewBitVector(1000)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		bitvector.HammingDistance(left, right)
+		bitvector.Jaccard(left, right)
+		bitvector.Cosine(left, right)
+	})
+	if allocs != 0 {
+		t.Errorf("allocs = %v, want 0", allocs)
+	}
+}
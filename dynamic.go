@@ -0,0 +1,492 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Leaves holding more bits than this are split in two.
+const dynamicLeafBits = 1024
+
+// DynamicBitVector is a bitvector that supports inserting and deleting bits at
+// any position. The bits are held in BitVector leaves of at most 1024 bits
+// kept in an AVL tree, each node caching the length and number of true bits
+// of its subtree, so Get, Set, Insert, Delete, Rank and Select are O(log n).
+type DynamicBitVector struct {
+	root *dynamicNode
+}
+
+type dynamicNode struct {
+	leaf     *BitVector
+	leafOnes int
+	left     *dynamicNode
+	right    *dynamicNode
+	height   int
+	length   int
+	ones     int
+}
+
+// Allocates an empty DynamicBitVector.
+func NewDynamicBitVector() *DynamicBitVector {
+	return &DynamicBitVector{}
+}
+
+// Allocates a DynamicBitVector with the same bit values as vector.
+func NewDynamicBitVectorFromVector(vector *BitVector) *DynamicBitVector {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+
+	leaves := []*BitVector{}
+	for offset := 0; offset < vector.Length(); offset += dynamicLeafBits / 2 {
+		leaf := NewBitVector(0)
+		for i := offset; i < vector.Length() && i < offset+dynamicLeafBits/2; i += bitsPerInt32 {
+			n := vector.Length() - i
+			if n > bitsPerInt32 {
+				n = bitsPerInt32
+			}
+			leaf.AppendBits(vector.getBits(i, n), n)
+		}
+		leaves = append(leaves, leaf)
+	}
+
+	return &DynamicBitVector{
+		root: buildDynamicNode(leaves),
+	}
+}
+
+func buildDynamicNode(leaves []*BitVector) *dynamicNode {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	middle := len(leaves) / 2
+	node := newDynamicNode(leaves[middle])
+	node.left = buildDynamicNode(leaves[:middle])
+	node.right = buildDynamicNode(leaves[middle+1:])
+	node.update()
+	return node
+}
+
+func (s *DynamicBitVector) Length() int {
+	return s.root.size()
+}
+
+// TrueBits returns the number of true bits.
+func (s *DynamicBitVector) TrueBits() int {
+	return s.root.trueBits()
+}
+
+// Returns the bit value at position index.
+func (s *DynamicBitVector) Get(index int) bool {
+	s.checkIndex(index, s.Length())
+
+	node := s.root
+	for {
+		left := node.left.size()
+		switch {
+		case index < left:
+			node = node.left
+		case index < left+node.leaf.Length():
+			return node.leaf.Get(index - left)
+		default:
+			index -= left + node.leaf.Length()
+			node = node.right
+		}
+	}
+}
+
+// Sets the bit value at position index to value.
+func (s *DynamicBitVector) Set(index int, bit bool) {
+	s.checkIndex(index, s.Length())
+
+	s.root.set(index, bit)
+}
+
+func (n *dynamicNode) set(index int, bit bool) int {
+	left := n.left.size()
+
+	delta := 0
+	switch {
+	case index < left:
+		delta = n.left.set(index, bit)
+	case index < left+n.leaf.Length():
+		index -= left
+		if n.leaf.Get(index) != bit {
+			n.leaf.Set(index, bit)
+			delta = bitDelta(bit)
+			n.leafOnes += delta
+		}
+	default:
+		delta = n.right.set(index-left-n.leaf.Length(), bit)
+	}
+
+	n.ones += delta
+	return delta
+}
+
+// Insert adds bit at position index, moving the bits from index onwards up by one.
+func (s *DynamicBitVector) Insert(index int, bit bool) {
+	s.checkIndex(index, s.Length()+1)
+
+	s.root = s.root.insert(index, bit)
+}
+
+func (n *dynamicNode) insert(index int, bit bool) *dynamicNode {
+	if n == nil {
+		leaf := NewBitVector(0)
+		leaf.Append(bit)
+		return newDynamicNode(leaf)
+	}
+
+	left := n.left.size()
+	switch {
+	case index < left:
+		n.left = n.left.insert(index, bit)
+	case index <= left+n.leaf.Length():
+		insertBit(n.leaf, index-left, bit)
+		if bit {
+			n.leafOnes++
+		}
+
+		if n.leaf.Length() > dynamicLeafBits {
+			n.right = n.right.insertLeftmost(newDynamicNode(n.splitLeaf()))
+		}
+	default:
+		n.right = n.right.insert(index-left-n.leaf.Length(), bit)
+	}
+
+	return n.balance()
+}
+
+func (n *dynamicNode) insertLeftmost(node *dynamicNode) *dynamicNode {
+	if n == nil {
+		return node
+	}
+
+	n.left = n.left.insertLeftmost(node)
+	return n.balance()
+}
+
+// splitLeaf moves the upper half of the leaf into a new leaf and returns it.
+func (n *dynamicNode) splitLeaf() *BitVector {
+	half := n.leaf.Length() / 2
+
+	upper := NewBitVector(0)
+	for i := half; i < n.leaf.Length(); i += bitsPerInt32 {
+		width := n.leaf.Length() - i
+		if width > bitsPerInt32 {
+			width = bitsPerInt32
+		}
+		upper.AppendBits(n.leaf.getBits(i, width), width)
+	}
+
+	n.leaf.Resize(half)
+	n.leafOnes = onesBefore(n.leaf, half)
+	return upper
+}
+
+// Delete removes and returns the bit at position index, moving the bits after it down by one.
+func (s *DynamicBitVector) Delete(index int) bool {
+	s.checkIndex(index, s.Length())
+
+	var bit bool
+	s.root, bit = s.root.delete(index)
+	return bit
+}
+
+func (n *dynamicNode) delete(index int) (*dynamicNode, bool) {
+	var bit bool
+
+	left := n.left.size()
+	switch {
+	case index < left:
+		n.left, bit = n.left.delete(index)
+	case index < left+n.leaf.Length():
+		bit = deleteBit(n.leaf, index-left)
+		if bit {
+			n.leafOnes--
+		}
+
+		if n.leaf.Length() == 0 {
+			return n.remove(), bit
+		}
+	default:
+		n.right, bit = n.right.delete(index - left - n.leaf.Length())
+	}
+
+	return n.balance(), bit
+}
+
+// remove unlinks n from its subtree and returns the new subtree root.
+func (n *dynamicNode) remove() *dynamicNode {
+	if n.left == nil {
+		return n.right
+	}
+	if n.right == nil {
+		return n.left
+	}
+
+	right, min := n.right.removeLeftmost()
+	min.left = n.left
+	min.right = right
+	return min.balance()
+}
+
+func (n *dynamicNode) removeLeftmost() (*dynamicNode, *dynamicNode) {
+	if n.left == nil {
+		return n.right, n
+	}
+
+	var min *dynamicNode
+	n.left, min = n.left.removeLeftmost()
+	return n.balance(), min
+}
+
+// Rank counts the number of true or false (depending on what the bit is set to)
+// in the bitvector but not including the offset
+func (s *DynamicBitVector) Rank(bit bool, offset int) int {
+	s.checkIndex(offset, s.Length()+1)
+
+	ones := 0
+	remaining := offset
+	node := s.root
+	for node != nil {
+		left := node.left.size()
+		switch {
+		case remaining < left:
+			node = node.left
+			continue
+		case remaining <= left+node.leaf.Length():
+			ones += node.left.trueBits() + onesBefore(node.leaf, remaining-left)
+			node = nil
+		default:
+			ones += node.left.trueBits() + node.leafOnes
+			remaining -= left + node.leaf.Length()
+			node = node.right
+		}
+	}
+
+	if bit {
+		return ones
+	}
+	return offset - ones
+}
+
+// Select returns the position of the bit with the given rank, counting from 0, or -1
+// if there are not that many true or false (depending on what the bit is set to) bits.
+func (s *DynamicBitVector) Select(bit bool, rank int) int {
+	if rank < 0 {
+		return -1
+	}
+
+	offset := 0
+	node := s.root
+	for node != nil {
+		left := node.left.count(bit)
+		leaf := node.leafOnes
+		if !bit {
+			leaf = node.leaf.Length() - node.leafOnes
+		}
+
+		switch {
+		case rank < left:
+			node = node.left
+		case rank < left+leaf:
+			return offset + node.left.size() + selectLeaf(node.leaf, bit, rank-left)
+		default:
+			rank -= left + leaf
+			offset += node.left.size() + node.leaf.Length()
+			node = node.right
+		}
+	}
+
+	return -1
+}
+
+// ToBitVector returns the bits as a flat BitVector.
+func (s *DynamicBitVector) ToBitVector() *BitVector {
+	vector := NewBitVector(0)
+	vector.Grow(s.Length())
+	s.root.walk(func(leaf *BitVector) {
+		vector.AppendVector(leaf)
+	})
+	return vector
+}
+
+func (s *DynamicBitVector) String() string {
+	return s.ToBitVector().String()
+}
+
+func (s *DynamicBitVector) checkIndex(index, length int) {
+	if index < 0 || index >= length {
+		panic(fmt.Errorf("%w: index %v", ErrIndexOutOfRange, index))
+	}
+}
+
+func newDynamicNode(leaf *BitVector) *dynamicNode {
+	node := &dynamicNode{
+		leaf:     leaf,
+		leafOnes: onesBefore(leaf, leaf.Length()),
+	}
+	node.update()
+	return node
+}
+
+func (n *dynamicNode) walk(fn func(leaf *BitVector)) {
+	if n == nil {
+		return
+	}
+
+	n.left.walk(fn)
+	fn(n.leaf)
+	n.right.walk(fn)
+}
+
+func (n *dynamicNode) size() int {
+	if n == nil {
+		return 0
+	}
+	return n.length
+}
+
+func (n *dynamicNode) trueBits() int {
+	if n == nil {
+		return 0
+	}
+	return n.ones
+}
+
+func (n *dynamicNode) count(bit bool) int {
+	if bit {
+		return n.trueBits()
+	}
+	return n.size() - n.trueBits()
+}
+
+func (n *dynamicNode) depth() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *dynamicNode) update() {
+	n.height = 1 + n.left.depth()
+	if right := n.right.depth(); right >= n.height {
+		n.height = 1 + right
+	}
+	n.length = n.left.size() + n.leaf.Length() + n.right.size()
+	n.ones = n.left.trueBits() + n.leafOnes + n.right.trueBits()
+}
+
+func (n *dynamicNode) balance() *dynamicNode {
+	n.update()
+
+	switch factor := n.left.depth() - n.right.depth(); {
+	case factor > 1:
+		if n.left.left.depth() < n.left.right.depth() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case factor < -1:
+		if n.right.right.depth() < n.right.left.depth() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+
+	return n
+}
+
+func (n *dynamicNode) rotateLeft() *dynamicNode {
+	root := n.right
+	n.right = root.left
+	root.left = n
+	n.update()
+	root.update()
+	return root
+}
+
+func (n *dynamicNode) rotateRight() *dynamicNode {
+	root := n.left
+	n.left = root.right
+	root.right = n
+	n.update()
+	root.update()
+	return root
+}
+
+// insertBit adds bit at index of vector, shifting the following words up by one bit.
+func insertBit(vector *BitVector, index int, bit bool) {
+	vector.Append(false)
+
+	word := index / bitsPerInt32
+	for i := len(vector.array) - 1; i > word; i-- {
+		vector.array[i] = vector.array[i]<<1 | vector.array[i-1]>>(bitsPerInt32-1)
+	}
+
+	low := uint32(1)<<(index%bitsPerInt32) - 1
+	value := vector.array[word]
+	vector.array[word] = value&low | (value&^low)<<1
+	vector.Set(index, bit)
+}
+
+// deleteBit removes and returns the bit at index of vector, shifting the following words down by one bit.
+func deleteBit(vector *BitVector, index int) bool {
+	bit := vector.Get(index)
+
+	word := index / bitsPerInt32
+	low := uint32(1)<<(index%bitsPerInt32) - 1
+	value := vector.array[word]
+	vector.array[word] = value&low | (value>>1)&^low
+	for i := word; i < len(vector.array)-1; i++ {
+		vector.array[i] |= vector.array[i+1] << (bitsPerInt32 - 1)
+		vector.array[i+1] >>= 1
+	}
+
+	vector.Resize(vector.Length() - 1)
+	return bit
+}
+
+// onesBefore counts the true bits of vector below index.
+func onesBefore(vector *BitVector, index int) int {
+	ones := 0
+	for i := 0; i < index/bitsPerInt32; i++ {
+		ones += bits.OnesCount32(vector.array[i])
+	}
+	if rest := index % bitsPerInt32; rest > 0 {
+		ones += bits.OnesCount32(vector.array[index/bitsPerInt32] & (1<<rest - 1))
+	}
+	return ones
+}
+
+// selectLeaf returns the position of the rank'th bit equal to bit in vector, which must exist.
+func selectLeaf(vector *BitVector, bit bool, rank int) int {
+	for i, word := range vector.array {
+		if !bit {
+			word = ^word
+			if rest := vector.Length() - i*bitsPerInt32; rest < bitsPerInt32 {
+				word &= 1<<rest - 1
+			}
+		}
+
+		count := bits.OnesCount32(word)
+		if rank < count {
+			for ; rank > 0; rank-- {
+				word &= word - 1
+			}
+			return i*bitsPerInt32 + bits.TrailingZeros32(word)
+		}
+		rank -= count
+	}
+
+	panic(fmt.Errorf("%w: rank %v", ErrIndexOutOfRange, rank))
+}
+
+func bitDelta(bit bool) int {
+	if bit {
+		return 1
+	}
+	return -1
+}
@@ -0,0 +1,107 @@
+package bitvector_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestDynamicBitVector_Insert_Delete(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := bitvector.NewDynamicBitVector()
+	want := []bool{}
+
+	for step := 0; step < 20000; step++ {
+		switch op := r.Intn(10); {
+		case op < 6 || len(want) == 0:
+			index := r.Intn(len(want) + 1)
+			bit := r.Intn(2) == 1
+			s.Insert(index, bit)
+			want = append(want[:index], append([]bool{bit}, want[index:]...)...)
+		case op < 8:
+			index := r.Intn(len(want))
+			got := s.Delete(index)
+			if got != want[index] {
+				t.Fatalf("DynamicBitVector.Delete(%v) = %v, want %v", index, got, want[index])
+			}
+			want = append(want[:index], want[index+1:]...)
+		default:
+			index := r.Intn(len(want))
+			bit := r.Intn(2) == 1
+			s.Set(index, bit)
+			want[index] = bit
+		}
+	}
+
+	checkDynamicBitVector(t, s, want)
+}
+
+func TestNewDynamicBitVectorFromVector(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	want := make([]bool, 5000)
+	for i := range want {
+		want[i] = r.Intn(3) == 0
+	}
+
+	s := bitvector.NewDynamicBitVectorFromVector(bitvector.NewBitVectorFromBool(want))
+	checkDynamicBitVector(t, s, want)
+
+	s.Insert(0, true)
+	s.Insert(2500, false)
+	s.Delete(4000)
+	want = append([]bool{true}, want...)
+	want = append(want[:2500], append([]bool{false}, want[2500:]...)...)
+	want = append(want[:4000], want[4001:]...)
+	checkDynamicBitVector(t, s, want)
+}
+
+func checkDynamicBitVector(t *testing.T, s *bitvector.DynamicBitVector, want []bool) {
+	t.Helper()
+
+	if s.Length() != len(want) {
+		t.Fatalf("DynamicBitVector.Length() = %v, want %v", s.Length(), len(want))
+	}
+
+	ones := 0
+	positions := map[bool][]int{}
+	for i, bit := range want {
+		if got := s.Get(i); got != bit {
+			t.Fatalf("DynamicBitVector.Get(%v) = %v, want %v", i, got, bit)
+		}
+		if i%97 == 0 {
+			if got := s.Rank(true, i); got != ones {
+				t.Fatalf("DynamicBitVector.Rank(true, %v) = %v, want %v", i, got, ones)
+			}
+			if got := s.Rank(false, i); got != i-ones {
+				t.Fatalf("DynamicBitVector.Rank(false, %v) = %v, want %v", i, got, i-ones)
+			}
+		}
+		if bit {
+			ones++
+		}
+		positions[bit] = append(positions[bit], i)
+	}
+
+	if got := s.TrueBits(); got != ones {
+		t.Errorf("DynamicBitVector.TrueBits() = %v, want %v", got, ones)
+	}
+
+	for _, bit := range []bool{true, false} {
+		for rank, index := range positions[bit] {
+			if got := s.Select(bit, rank); got != index {
+				t.Fatalf("DynamicBitVector.Select(%v, %v) = %v, want %v", bit, rank, got, index)
+			}
+		}
+		if got := s.Select(bit, len(positions[bit])); got != -1 {
+			t.Errorf("DynamicBitVector.Select(%v, %v) = %v, want %v", bit, len(positions[bit]), got, -1)
+		}
+	}
+
+	flat := s.ToBitVector()
+	for i, bit := range want {
+		if flat.Get(i) != bit {
+			t.Fatalf("DynamicBitVector.ToBitVector().Get(%v) = %v, want %v", i, flat.Get(i), bit)
+		}
+	}
+}
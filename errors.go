@@ -0,0 +1,26 @@
+package bitvector
+
+import "errors"
+
+var (
+	// ErrIndexOutOfRange is returned when an index or range falls outside the bitvector.
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrLengthMismatch is returned when two bitvectors do not have compatible lengths.
+	ErrLengthMismatch = errors.New("length mismatch")
+	// ErrConcurrentModification is returned by an iterator when its bitvector changed after it was created.
+	ErrConcurrentModification = errors.New("version failed")
+	// ErrNilVector is returned when a nil bitvector is passed as an argument.
+	ErrNilVector = errors.New("vector is null")
+	// ErrNegativeLength is returned when a negative length is requested.
+	ErrNegativeLength = errors.New("need non-negative number")
+	// ErrInvalidEncoding is returned when decoding data that was not produced by the matching encoder.
+	ErrInvalidEncoding = errors.New("invalid encoding")
+	// ErrNoSolution is returned when a system of equations over GF(2) is inconsistent.
+	ErrNoSolution = errors.New("no solution")
+	// ErrSingular is returned when inverting a matrix that has no inverse.
+	ErrSingular = errors.New("matrix is singular")
+	// ErrDivisionByZero is returned when dividing by the zero polynomial.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrInvalidArgument is returned when a size or rate parameter is outside its allowed range.
+	ErrInvalidArgument = errors.New("invalid argument")
+)
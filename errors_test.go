@@ -0,0 +1,145 @@
+package bitvector_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitVector_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func() error
+		want error
+	}{
+		{
+			name: "TryGet negative",
+			fn: func() error {
+				_, err := bitvector.NewBitVector(4).TryGet(-1)
+				return err
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TryGet past length",
+			fn: func() error {
+				_, err := bitvector.NewBitVector(4).TryGet(4)
+				return err
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TrySet",
+			fn: func() error {
+				return bitvector.NewBitVector(4).TrySet(4, true)
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TrySet in range",
+			fn: func() error {
+				return bitvector.NewBitVector(4).TrySet(3, true)
+			},
+			want: nil,
+		},
+		{
+			name: "TryAnd length",
+			fn: func() error {
+				return bitvector.NewBitVector(4).TryAnd(bitvector.NewBitVector(5))
+			},
+			want: bitvector.ErrLengthMismatch,
+		},
+		{
+			name: "TryOr nil",
+			fn: func() error {
+				return bitvector.NewBitVector(4).TryOr(nil)
+			},
+			want: bitvector.ErrNilVector,
+		},
+		{
+			name: "TryXor length",
+			fn: func() error {
+				return bitvector.NewBitVector(4).TryXor(bitvector.NewBitVector(3))
+			},
+			want: bitvector.ErrLengthMismatch,
+		},
+		{
+			name: "TryCopy",
+			fn: func() error {
+				return bitvector.NewBitVector(4).TryCopy(bitvector.NewBitVector(4), 0, 5)
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TryCopy indexEnd at aligned length",
+			fn: func() error {
+				return bitvector.NewBitVector(64).TryCopy(bitvector.NewBitVector(128), 0, 64)
+			},
+			want: nil,
+		},
+		{
+			name: "TryCopy indexStart past length",
+			fn: func() error {
+				return bitvector.NewBitVector(64).TryCopy(bitvector.NewBitVector(128), 64, 64)
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TryCopy undersized destination",
+			fn: func() error {
+				return bitvector.NewBitVector(128).TryCopy(bitvector.NewBitVector(32), 0, 96)
+			},
+			want: bitvector.ErrLengthMismatch,
+		},
+		{
+			name: "TryResize",
+			fn: func() error {
+				return bitvector.NewBitVector(4).TryResize(-1)
+			},
+			want: bitvector.ErrNegativeLength,
+		},
+		{
+			name: "TryRank",
+			fn: func() error {
+				_, err := bitvector.NewBitVector(4).TryRank(true, 5)
+				return err
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TryNext",
+			fn: func() error {
+				s := bitvector.NewBitVector(4)
+				iterator := s.Enumerate()
+				s.SetAll(true)
+				_, _, err := iterator.TryNext()
+				return err
+			},
+			want: bitvector.ErrConcurrentModification,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fn()
+			if tt.want == nil && err != nil {
+				t.Errorf("err = %v, want nil", err)
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitVector_PanicWrapsError(t *testing.T) {
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, bitvector.ErrIndexOutOfRange) {
+			t.Errorf("recover() = %v, want %v", r, bitvector.ErrIndexOutOfRange)
+		}
+	}()
+
+	bitvector.NewBitVector(4).Get(4)
+}
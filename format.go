@@ -0,0 +1,118 @@
+package bitvector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Format implements fmt.Formatter.
+//
+//	%b   bits as 0 and 1 digits, index 0 first
+//	%x   bits as hex digits of 4 bits, index 0 first, %X for upper case
+//	%v   same as %b
+//	%+v  length and number of true bits followed by the bits
+//	%#v  Go syntax that constructs the vector
+//	%s   same as String
+//
+// For %b and %x the + flag writes the highest index first, like a binary number,
+// and a precision groups the digits in runs of that many separated by '_',
+// counting from index 0. The width pads the result with spaces, on the right
+// with the - flag.
+func (s BitVector) Format(f fmt.State, verb rune) {
+	var str string
+	switch verb {
+	case 'b':
+		str = s.digits(f, 1, false)
+	case 'x':
+		str = s.digits(f, 4, false)
+	case 'X':
+		str = s.digits(f, 4, true)
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			str = s.goString()
+		case f.Flag('+'):
+			str = fmt.Sprintf("{length: %v, true: %v, bits: %s}", s.length, onesBefore(&s, s.length), s.digits(nil, 1, false))
+		default:
+			str = s.digits(nil, 1, false)
+		}
+	case 's':
+		str = s.String()
+	default:
+		fmt.Fprintf(f, "%%!%c(bitvector.BitVector=%s)", verb, s.digits(nil, 1, false))
+		return
+	}
+
+	pad(f, str)
+}
+
+// digits writes the bits as base 2^size digits, honouring the + flag and precision of f when it is not nil.
+func (s BitVector) digits(f fmt.State, size int, upper bool) string {
+	digits := "0123456789abcdef"
+	if upper {
+		digits = "0123456789ABCDEF"
+	}
+
+	count, err := getArrayLength(s.length, size)
+	if err != nil {
+		panic(err)
+	}
+
+	msbFirst := false
+	group := 0
+	if f != nil {
+		msbFirst = f.Flag('+')
+		if precision, ok := f.Precision(); ok {
+			group = precision
+		}
+	}
+
+	var builder strings.Builder
+	for i := 0; i < count; i++ {
+		index := i
+		if msbFirst {
+			index = count - 1 - i
+		}
+
+		if group > 0 && i > 0 {
+			boundary := index%group == 0
+			if msbFirst {
+				boundary = (index+1)%group == 0
+			}
+			if boundary {
+				builder.WriteByte('_')
+			}
+		}
+
+		width := s.length - index*size
+		if width > size {
+			width = size
+		}
+		builder.WriteByte(digits[s.getBits(index*size, width)])
+	}
+	return builder.String()
+}
+
+func (s BitVector) goString() string {
+	values := make([]string, s.length)
+	for i := range values {
+		values[i] = strconv.FormatBool(s.Get(i))
+	}
+	return fmt.Sprintf("bitvector.NewBitVectorFromBool([]bool{%s})", strings.Join(values, ", "))
+}
+
+func pad(f fmt.State, str string) {
+	width, ok := f.Width()
+	if !ok || width <= len(str) {
+		fmt.Fprint(f, str)
+		return
+	}
+
+	padding := strings.Repeat(" ", width-len(str))
+	if f.Flag('-') {
+		fmt.Fprint(f, str, padding)
+	} else {
+		fmt.Fprint(f, padding, str)
+	}
+}
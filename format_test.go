@@ -0,0 +1,97 @@
+package bitvector_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitVector_Format(t *testing.T) {
+	values := []bool{true, false, true, true, false, false, false, false, true, true}
+
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{
+			name:   "binary",
+			format: "%b",
+			want:   "1011000011",
+		},
+		{
+			name:   "binary msb first",
+			format: "%+b",
+			want:   "1100001101",
+		},
+		{
+			name:   "binary grouped",
+			format: "%.4b",
+			want:   "1011_0000_11",
+		},
+		{
+			name:   "binary msb first grouped",
+			format: "%+.4b",
+			want:   "11_0000_1101",
+		},
+		{
+			name:   "hex",
+			format: "%x",
+			want:   "d03",
+		},
+		{
+			name:   "hex msb first",
+			format: "%+X",
+			want:   "30D",
+		},
+		{
+			name:   "compact",
+			format: "%v",
+			want:   "1011000011",
+		},
+		{
+			name:   "verbose",
+			format: "%+v",
+			want:   "{length: 10, true: 5, bits: 1011000011}",
+		},
+		{
+			name:   "width",
+			format: "%12b|%-12b|",
+			want:   "  1011000011|1011000011  |",
+		},
+		{
+			name:   "string",
+			format: "%s",
+			want:   "{ true, false, true, true, false, false, false, false, true, true }\n",
+		},
+		{
+			name:   "go syntax",
+			format: "%#v",
+			want:   "bitvector.NewBitVectorFromBool([]bool{true, false, true, true, false, false, false, false, true, true})",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bitvector.NewBitVectorFromBool(values)
+			args := []interface{}{s}
+			if tt.name == "width" {
+				args = append(args, s)
+			}
+
+			if got := fmt.Sprintf(tt.format, args...); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitVector_Format_IgnoresBitsPastLength(t *testing.T) {
+	s := bitvector.NewBitVectorOfLength(5, true)
+	if got := fmt.Sprintf("%+v", s); got != "{length: 5, true: 5, bits: 11111}" {
+		t.Errorf("Sprintf(%%+v) = %q", got)
+	}
+	if got := fmt.Sprintf("%x", s); got != "f1" {
+		t.Errorf("Sprintf(%%x) = %q", got)
+	}
+}
@@ -0,0 +1,221 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Number of rows of B combined into each Method of Four Russians table.
+const fourRussiansBits = 8
+
+// Clone returns a copy of the matrix.
+func (s *BitMatrix) Clone() *BitMatrix {
+	return &BitMatrix{
+		vector: NewBitVectorFromVector(*s.vector),
+		rows:   s.rows,
+		cols:   s.cols,
+		stride: s.stride,
+	}
+}
+
+// ReducedRowEchelon returns the reduced row echelon form of the matrix over GF(2)
+// and the pivot column of each non zero row, which is the rank long.
+func (s *BitMatrix) ReducedRowEchelon() (*BitMatrix, []int) {
+	reduced := s.Clone()
+	return reduced, reduced.eliminate(s.cols)
+}
+
+// Rank returns the rank of the matrix over GF(2).
+func (s *BitMatrix) Rank() int {
+	_, pivots := s.ReducedRowEchelon()
+	return len(pivots)
+}
+
+// eliminate reduces s in place to reduced row echelon form using pivots in the
+// first cols columns and returns the pivot columns.
+func (s *BitMatrix) eliminate(cols int) []int {
+	pivots := []int{}
+	row := 0
+	for c := 0; c < cols && row < s.rows; c++ {
+		pivot := -1
+		for r := row; r < s.rows; r++ {
+			if s.Get(r, c) {
+				pivot = r
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+
+		s.swapRows(row, pivot)
+		pivotRow := s.Row(row)
+		for r := 0; r < s.rows; r++ {
+			if r != row && s.Get(r, c) {
+				s.Row(r).Xor(pivotRow)
+			}
+		}
+
+		pivots = append(pivots, c)
+		row++
+	}
+	return pivots
+}
+
+func (s *BitMatrix) swapRows(a, b int) {
+	if a == b {
+		return
+	}
+
+	x := s.vector.array[a*s.stride : (a+1)*s.stride]
+	y := s.vector.array[b*s.stride : (b+1)*s.stride]
+	for i := range x {
+		x[i], y[i] = y[i], x[i]
+	}
+}
+
+// Solve finds x with A x = b over GF(2). It returns one solution and a basis of
+// the null space of A, every solution is x plus a sum of basis vectors. It
+// returns ErrNoSolution if the system is inconsistent.
+func Solve(a *BitMatrix, b *BitVector) (*BitVector, []*BitVector, error) {
+	if a == nil || b == nil {
+		return nil, nil, ErrNilVector
+	}
+	if b.Length() != a.rows {
+		return nil, nil, fmt.Errorf("%w: b length %v is not %v rows", ErrLengthMismatch, b.Length(), a.rows)
+	}
+
+	augmented := a.hconcat(NewBitMatrixFromRows([]*BitVector{b}).Transpose())
+	pivots := augmented.eliminate(a.cols + 1)
+
+	if len(pivots) > 0 && pivots[len(pivots)-1] == a.cols {
+		return nil, nil, ErrNoSolution
+	}
+
+	x := NewBitVector(a.cols)
+	pivotColumns := make([]bool, a.cols)
+	for r, c := range pivots {
+		x.Set(c, augmented.Get(r, a.cols))
+		pivotColumns[c] = true
+	}
+
+	nullSpace := []*BitVector{}
+	for free := 0; free < a.cols; free++ {
+		if pivotColumns[free] {
+			continue
+		}
+
+		basis := NewBitVector(a.cols)
+		basis.Set(free, true)
+		for r, c := range pivots {
+			basis.Set(c, augmented.Get(r, free))
+		}
+		nullSpace = append(nullSpace, basis)
+	}
+
+	return x, nullSpace, nil
+}
+
+// Inverse returns the inverse of a square matrix over GF(2), or ErrSingular.
+func (s *BitMatrix) Inverse() (*BitMatrix, error) {
+	if s.rows != s.cols {
+		return nil, fmt.Errorf("%w: matrix %vx%v is not square", ErrLengthMismatch, s.rows, s.cols)
+	}
+
+	augmented := s.hconcat(NewIdentityBitMatrix(s.rows))
+	if pivots := augmented.eliminate(s.cols); len(pivots) < s.rows {
+		return nil, ErrSingular
+	}
+
+	return augmented.columns(s.cols, 2*s.cols), nil
+}
+
+// MulVector returns A v over GF(2), bit r is the parity of row r of A ANDed with v.
+func (s *BitMatrix) MulVector(vector *BitVector) *BitVector {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+	if vector.Length() != s.cols {
+		panic(fmt.Errorf("%w: vector length %v is not %v columns", ErrLengthMismatch, vector.Length(), s.cols))
+	}
+
+	result := NewBitVector(s.rows)
+	for r := 0; r < s.rows; r++ {
+		row := s.Row(r)
+		parity := 0
+		for i := range row.array {
+			parity ^= bits.OnesCount32(row.maskedWord(i) & vector.array[i])
+		}
+		if parity&1 == 1 {
+			result.array[r/bitsPerInt32] |= 1 << (r % bitsPerInt32)
+		}
+	}
+	return result
+}
+
+// Mul returns A B over GF(2) using the Method of Four Russians, each group of 8
+// rows of B is combined into a table of all 256 XOR sums so each row of A needs
+// one table lookup and XOR per group instead of one per set bit.
+func (s *BitMatrix) Mul(matrix *BitMatrix) *BitMatrix {
+	if matrix == nil {
+		panic(ErrNilVector)
+	}
+	if s.cols != matrix.rows {
+		panic(fmt.Errorf("%w: matrix %vx%v can not multiply %vx%v", ErrLengthMismatch, s.rows, s.cols, matrix.rows, matrix.cols))
+	}
+
+	result := NewBitMatrix(s.rows, matrix.cols)
+
+	table := make([]*BitVector, 1<<fourRussiansBits)
+	for i := range table {
+		table[i] = NewBitVector(matrix.cols)
+	}
+
+	for g := 0; g < s.cols; g += fourRussiansBits {
+		width := minInt(fourRussiansBits, s.cols-g)
+
+		for i := 1; i < 1<<width; i++ {
+			copy(table[i].array, table[i&(i-1)].array)
+			table[i].Xor(matrix.Row(g + bits.TrailingZeros(uint(i))))
+		}
+
+		for r := 0; r < s.rows; r++ {
+			if index := s.vector.getBits(r*s.stride*bitsPerInt32+g, width); index != 0 {
+				result.Row(r).Xor(table[index])
+			}
+		}
+	}
+
+	return result
+}
+
+// hconcat returns a new matrix with the columns of matrix placed after the columns of s.
+func (s *BitMatrix) hconcat(matrix *BitMatrix) *BitMatrix {
+	if s.rows != matrix.rows {
+		panic(fmt.Errorf("%w: matrix has %v rows not %v", ErrLengthMismatch, matrix.rows, s.rows))
+	}
+
+	result := NewBitMatrix(s.rows, s.cols+matrix.cols)
+	for r := 0; r < s.rows; r++ {
+		copyBits(result.vector, r*result.stride*bitsPerInt32, s.vector, r*s.stride*bitsPerInt32, s.cols)
+		copyBits(result.vector, r*result.stride*bitsPerInt32+s.cols, matrix.vector, r*matrix.stride*bitsPerInt32, matrix.cols)
+	}
+	return result
+}
+
+// columns returns a new matrix of the columns from start up to end.
+func (s *BitMatrix) columns(start, end int) *BitMatrix {
+	result := NewBitMatrix(s.rows, end-start)
+	for r := 0; r < s.rows; r++ {
+		copyBits(result.vector, r*result.stride*bitsPerInt32, s.vector, r*s.stride*bitsPerInt32+start, end-start)
+	}
+	return result
+}
+
+// copyBits copies length bits of src starting at srcOffset into dst starting at dstOffset.
+func copyBits(dst *BitVector, dstOffset int, src *BitVector, srcOffset, length int) {
+	for i := 0; i < length; i += 64 {
+		width := minInt(64, length-i)
+		dst.setBits(dstOffset+i, width, src.getBits(srcOffset+i, width))
+	}
+}
@@ -0,0 +1,138 @@
+package bitvector_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func naiveMul(a, b *bitvector.BitMatrix) *bitvector.BitMatrix {
+	result := bitvector.NewBitMatrix(a.Rows(), b.Cols())
+	for i := 0; i < a.Rows(); i++ {
+		for j := 0; j < b.Cols(); j++ {
+			bit := false
+			for k := 0; k < a.Cols(); k++ {
+				bit = bit != (a.Get(i, k) && b.Get(k, j))
+			}
+			result.Set(i, j, bit)
+		}
+	}
+	return result
+}
+
+func TestBitMatrix_Mul(t *testing.T) {
+	tests := []struct {
+		name    string
+		n, k, m int
+	}{
+		{"small", 3, 4, 5},
+		{"group boundary", 9, 17, 33},
+		{"large", 70, 100, 65},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(tt.n)))
+			a := randomBitMatrix(r, tt.n, tt.k)
+			b := randomBitMatrix(r, tt.k, tt.m)
+
+			if got, want := a.Mul(b), naiveMul(a, b); !got.Equal(want) {
+				t.Errorf("BitMatrix.Mul() =\n%v, want\n%v", got, want)
+			}
+
+			v := randomBitVector(r, tt.k)
+			column := bitvector.NewBitMatrixFromRows([]*bitvector.BitVector{v}).Transpose()
+			want := naiveMul(a, column).Column(0)
+			if got := a.MulVector(v); !got.Equal(want) {
+				t.Errorf("BitMatrix.MulVector() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBitMatrix_Rank(t *testing.T) {
+	if got := bitvector.NewIdentityBitMatrix(40).Rank(); got != 40 {
+		t.Errorf("BitMatrix.Rank() identity = %v, want %v", got, 40)
+	}
+
+	s := bitvector.NewBitMatrix(3, 3)
+	s.Set(0, 0, true)
+	s.Set(0, 1, true)
+	s.Set(1, 1, true)
+	s.Set(1, 2, true)
+	s.Set(2, 0, true)
+	s.Set(2, 2, true)
+	if got := s.Rank(); got != 2 {
+		t.Errorf("BitMatrix.Rank() = %v, want %v", got, 2)
+	}
+
+	reduced, pivots := s.ReducedRowEchelon()
+	if len(pivots) != 2 || pivots[0] != 0 || pivots[1] != 1 {
+		t.Errorf("BitMatrix.ReducedRowEchelon() pivots = %v, want [0 1]", pivots)
+	}
+	if reduced.RowTrueBits(2) != 0 {
+		t.Errorf("BitMatrix.ReducedRowEchelon() last row = %v, want empty", reduced.Row(2))
+	}
+}
+
+func TestSolve(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 50; i++ {
+		rows, cols := r.Intn(40)+1, r.Intn(40)+1
+		a := randomBitMatrix(r, rows, cols)
+		b := a.MulVector(randomBitVector(r, cols))
+
+		x, nullSpace, err := bitvector.Solve(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := a.MulVector(x); !got.Equal(b) {
+			t.Fatalf("A x = %v, want %v", got, b)
+		}
+		if len(nullSpace) != cols-a.Rank() {
+			t.Fatalf("len(nullSpace) = %v, want %v", len(nullSpace), cols-a.Rank())
+		}
+		for _, basis := range nullSpace {
+			if !a.MulVector(basis).IsEmpty() {
+				t.Fatalf("A n = %v, want 0", a.MulVector(basis))
+			}
+		}
+	}
+
+	a := bitvector.NewBitMatrix(2, 1)
+	a.Set(0, 0, true)
+	a.Set(1, 0, true)
+	b := bitvector.NewBitVectorFromBool([]bool{true, false})
+	if _, _, err := bitvector.Solve(a, b); !errors.Is(err, bitvector.ErrNoSolution) {
+		t.Errorf("Solve() err = %v, want %v", err, bitvector.ErrNoSolution)
+	}
+}
+
+func TestBitMatrix_Inverse(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	inverted := 0
+	for i := 0; i < 50; i++ {
+		n := r.Intn(70) + 1
+		a := randomBitMatrix(r, n, n)
+
+		inverse, err := a.Inverse()
+		if a.Rank() < n {
+			if !errors.Is(err, bitvector.ErrSingular) {
+				t.Fatalf("BitMatrix.Inverse() err = %v, want %v", err, bitvector.ErrSingular)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !a.Mul(inverse).Equal(bitvector.NewIdentityBitMatrix(n)) {
+			t.Fatalf("A A^-1 != I for n = %v", n)
+		}
+		inverted++
+	}
+
+	if inverted == 0 {
+		t.Errorf("no invertible matrices were tested")
+	}
+}
@@ -0,0 +1,141 @@
+package bitvector
+
+import "encoding/binary"
+
+// Hash64 returns a seeded 64 bit hash of the length and bit values. It is stable
+// across processes and platforms and ignores any bits past the length, so equal
+// vectors always hash the same.
+func (s *BitVector) Hash64(seed uint64) uint64 {
+	hash := mix64(seed ^ uint64(s.length)*0x9e3779b97f4a7c15)
+
+	arrayLength := s.words()
+	for i := 0; i < arrayLength; i += 2 {
+		word := uint64(s.maskedWord(i))
+		if i+1 < arrayLength {
+			word |= uint64(s.maskedWord(i+1)) << 32
+		}
+		hash = mix64((hash ^ word) + 0x9e3779b97f4a7c15)
+	}
+
+	return hash
+}
+
+// Key returns the length and bit values as a string that is equal for equal
+// vectors, so it can be used as a map key.
+func (s *BitVector) Key() string {
+	arrayLength := s.words()
+
+	key := make([]byte, 8, 8+arrayLength*4)
+	binary.LittleEndian.PutUint64(key, uint64(s.length))
+	for i := 0; i < arrayLength; i++ {
+		key = binary.LittleEndian.AppendUint32(key, s.maskedWord(i))
+	}
+	return string(key)
+}
+
+// VectorMap maps bitvectors to values by content, two vectors with the same
+// length and bit values are the same key.
+type VectorMap[V any] struct {
+	items map[string]vectorEntry[V]
+}
+
+type vectorEntry[V any] struct {
+	vector *BitVector
+	value  V
+}
+
+// Allocates an empty VectorMap.
+func NewVectorMap[V any]() *VectorMap[V] {
+	return &VectorMap[V]{
+		items: map[string]vectorEntry[V]{},
+	}
+}
+
+// Put sets the value for vector. The map keeps its own copy of vector.
+func (s *VectorMap[V]) Put(vector *BitVector, value V) {
+	key := vector.Key()
+	if entry, ok := s.items[key]; ok {
+		entry.value = value
+		s.items[key] = entry
+		return
+	}
+
+	s.items[key] = vectorEntry[V]{
+		vector: NewBitVectorFromVector(*vector),
+		value:  value,
+	}
+}
+
+// Get returns the value for vector and whether it was present.
+func (s *VectorMap[V]) Get(vector *BitVector) (V, bool) {
+	entry, ok := s.items[vector.Key()]
+	return entry.value, ok
+}
+
+// Delete removes vector from the map.
+func (s *VectorMap[V]) Delete(vector *BitVector) {
+	delete(s.items, vector.Key())
+}
+
+// Len returns the number of distinct vectors in the map.
+func (s *VectorMap[V]) Len() int {
+	return len(s.items)
+}
+
+// Range calls fn for every vector and value until fn returns false. Each vector
+// is a copy, so changing it does not affect the map.
+func (s *VectorMap[V]) Range(fn func(vector *BitVector, value V) bool) {
+	for _, entry := range s.items {
+		if !fn(NewBitVectorFromVector(*entry.vector), entry.value) {
+			return
+		}
+	}
+}
+
+// VectorSet holds distinct bitvectors by content.
+type VectorSet struct {
+	items *VectorMap[struct{}]
+}
+
+// Allocates an empty VectorSet.
+func NewVectorSet() *VectorSet {
+	return &VectorSet{
+		items: NewVectorMap[struct{}](),
+	}
+}
+
+// Add inserts vector and reports whether it was not already present.
+func (s *VectorSet) Add(vector *BitVector) bool {
+	if s.Contains(vector) {
+		return false
+	}
+
+	s.items.Put(vector, struct{}{})
+	return true
+}
+
+// Contains reports whether a vector with the same content is in the set.
+func (s *VectorSet) Contains(vector *BitVector) bool {
+	_, ok := s.items.Get(vector)
+	return ok
+}
+
+// Remove deletes vector from the set.
+func (s *VectorSet) Remove(vector *BitVector) {
+	s.items.Delete(vector)
+}
+
+// Len returns the number of distinct vectors in the set.
+func (s *VectorSet) Len() int {
+	return s.items.Len()
+}
+
+// Vectors returns copies of the distinct vectors in the set in no particular order.
+func (s *VectorSet) Vectors() []*BitVector {
+	vectors := make([]*BitVector, 0, s.items.Len())
+	s.items.Range(func(vector *BitVector, _ struct{}) bool {
+		vectors = append(vectors, vector)
+		return true
+	})
+	return vectors
+}
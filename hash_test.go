@@ -0,0 +1,92 @@
+package bitvector_test
+
+import (
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestBitVector_Hash64(t *testing.T) {
+	full := bitvector.NewBitVectorOfLength(40, true)
+	notEmpty := bitvector.NewBitVector(40)
+	notEmpty.Not()
+
+	if full.Hash64(1) != notEmpty.Hash64(1) {
+		t.Errorf("BitVector.Hash64() differs for equal vectors")
+	}
+	if full.Key() != notEmpty.Key() {
+		t.Errorf("BitVector.Key() differs for equal vectors")
+	}
+	if full.Hash64(1) == full.Hash64(2) {
+		t.Errorf("BitVector.Hash64() ignores the seed")
+	}
+
+	shorter := bitvector.NewBitVectorOfLength(39, true)
+	if full.Hash64(1) == shorter.Hash64(1) || full.Key() == shorter.Key() {
+		t.Errorf("BitVector.Hash64() ignores the length")
+	}
+
+	zeros := bitvector.NewBitVector(32)
+	if zeros.Hash64(0) == bitvector.NewBitVector(64).Hash64(0) {
+		t.Errorf("BitVector.Hash64() ignores the length of zero vectors")
+	}
+
+	// Fixed value so a change to the hash, which would break stored hashes, is noticed.
+	if got := bitvector.NewBitVectorFromBool([]bool{true, false, true}).Hash64(0); got != 0x2c833d47879d324f {
+		t.Errorf("BitVector.Hash64() = %#x", got)
+	}
+}
+
+func TestVectorSet(t *testing.T) {
+	s := bitvector.NewVectorSet()
+
+	a := bitvector.NewBitVectorFromBool([]bool{true, false, true})
+	if !s.Add(a) {
+		t.Errorf("VectorSet.Add() = false, want true")
+	}
+	if s.Add(bitvector.NewBitVectorFromBool([]bool{true, false, true})) {
+		t.Errorf("VectorSet.Add() duplicate = true, want false")
+	}
+	if !s.Add(bitvector.NewBitVectorFromBool([]bool{true, false, true, false})) {
+		t.Errorf("VectorSet.Add() longer = false, want true")
+	}
+
+	a.Set(1, true)
+	if s.Contains(a) {
+		t.Errorf("VectorSet.Contains() after changing the added vector = true, want false")
+	}
+
+	if s.Len() != 2 || len(s.Vectors()) != 2 {
+		t.Errorf("VectorSet.Len() = %v, want %v", s.Len(), 2)
+	}
+
+	for _, vector := range s.Vectors() {
+		vector.SetAll(true)
+	}
+	for _, vector := range s.Vectors() {
+		if !s.Contains(vector) {
+			t.Errorf("VectorSet.Contains(%v) after changing Vectors() = false, want true", vector)
+		}
+	}
+	if !s.Contains(bitvector.NewBitVectorFromBool([]bool{true, false, true})) {
+		t.Errorf("VectorSet.Contains() after changing Vectors() = false, want true")
+	}
+
+	s.Remove(bitvector.NewBitVectorFromBool([]bool{true, false, true}))
+	if s.Len() != 1 {
+		t.Errorf("VectorSet.Len() = %v, want %v", s.Len(), 1)
+	}
+}
+
+func TestVectorMap(t *testing.T) {
+	s := bitvector.NewVectorMap[int]()
+	s.Put(bitvector.NewBitVectorFromBool([]bool{true}), 1)
+	s.Put(bitvector.NewBitVectorFromBool([]bool{true}), 2)
+
+	if got, ok := s.Get(bitvector.NewBitVectorFromBool([]bool{true})); !ok || got != 2 {
+		t.Errorf("VectorMap.Get() = %v, %v, want %v, true", got, ok, 2)
+	}
+	if _, ok := s.Get(bitvector.NewBitVectorFromBool([]bool{false})); ok {
+		t.Errorf("VectorMap.Get() = true, want false")
+	}
+}
@@ -0,0 +1,79 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// FromIndices allocates a BitVector of length bits with the bits at indices set to true.
+func FromIndices(indices []int, length int) (*BitVector, error) {
+	if length < 0 {
+		return nil, ErrNegativeLength
+	}
+
+	vector := NewBitVector(length)
+	for _, index := range indices {
+		if err := vector.TrySet(index, true); err != nil {
+			return nil, err
+		}
+	}
+	return vector, nil
+}
+
+// FromUint32s allocates a BitVector of length bits from words, bit i is bit i%32 of words[i/32].
+func FromUint32s(words []uint32, length int) (*BitVector, error) {
+	if length < 0 {
+		return nil, ErrNegativeLength
+	}
+	arrayLength, err := getArrayLength(length, bitsPerInt32)
+	if err != nil {
+		return nil, err
+	}
+	if arrayLength > len(words) {
+		return nil, fmt.Errorf("%w: %v bits need %v words got %v", ErrLengthMismatch, length, arrayLength, len(words))
+	}
+
+	vector := NewBitVector(length)
+	copy(vector.array, words)
+	vector.clearTail()
+	return vector, nil
+}
+
+// FromUint64s allocates a BitVector of length bits from words, bit i is bit i%64 of words[i/64].
+func FromUint64s(words []uint64, length int) (*BitVector, error) {
+	if length < 0 {
+		return nil, ErrNegativeLength
+	}
+	arrayLength, err := getArrayLength(length, 2*bitsPerInt32)
+	if err != nil {
+		return nil, err
+	}
+	if arrayLength > len(words) {
+		return nil, fmt.Errorf("%w: %v bits need %v words got %v", ErrLengthMismatch, length, arrayLength, len(words))
+	}
+
+	vector := NewBitVector(length)
+	for i := range vector.array {
+		vector.array[i] = uint32(words[i/2] >> (bitsPerInt32 * (i % 2)))
+	}
+	vector.clearTail()
+	return vector, nil
+}
+
+// ToIndices returns the positions of the true bits in increasing order.
+func (s *BitVector) ToIndices() []int {
+	return s.AppendIndices(nil)
+}
+
+// AppendIndices appends the positions of the true bits in increasing order to dst and returns the extended slice.
+func (s *BitVector) AppendIndices(dst []int) []int {
+	arrayLength := s.words()
+	for i := 0; i < arrayLength; i++ {
+		word := s.maskedWord(i)
+		for word != 0 {
+			dst = append(dst, i*bitsPerInt32+bits.TrailingZeros32(word))
+			word &= word - 1
+		}
+	}
+	return dst
+}
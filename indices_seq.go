@@ -0,0 +1,20 @@
+//go:build go1.23
+
+package bitvector
+
+import "iter"
+
+// FromSeq allocates a BitVector of length bits with the bits at each index yielded by seq set to true.
+func FromSeq(seq iter.Seq[int], length int) (*BitVector, error) {
+	if length < 0 {
+		return nil, ErrNegativeLength
+	}
+
+	vector := NewBitVector(length)
+	for index := range seq {
+		if err := vector.TrySet(index, true); err != nil {
+			return nil, err
+		}
+	}
+	return vector, nil
+}
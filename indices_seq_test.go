@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package bitvector_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestFromSeq(t *testing.T) {
+	s, err := bitvector.FromSeq(slices.Values([]int{4, 0, 40}), 41)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ToIndices(); !reflect.DeepEqual(got, []int{0, 4, 40}) {
+		t.Errorf("FromSeq().ToIndices() = %v, want %v", got, []int{0, 4, 40})
+	}
+
+	if _, err := bitvector.FromSeq(slices.Values([]int{41}), 41); err == nil {
+		t.Errorf("FromSeq() err = nil, want error")
+	}
+}
@@ -0,0 +1,84 @@
+package bitvector_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestFromIndices(t *testing.T) {
+	tests := []struct {
+		name    string
+		indices []int
+		length  int
+		want    []int
+	}{
+		{
+			name:    "indices",
+			indices: []int{0, 3, 31, 32, 69},
+			length:  70,
+			want:    []int{0, 3, 31, 32, 69},
+		},
+		{
+			name:    "unordered duplicates",
+			indices: []int{5, 1, 5},
+			length:  6,
+			want:    []int{1, 5},
+		},
+		{
+			name:    "empty",
+			indices: nil,
+			length:  10,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := bitvector.FromIndices(tt.indices, tt.length)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.ToIndices(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BitVector.ToIndices() = %v, want %v", got, tt.want)
+			}
+			if got := s.AppendIndices([]int{-1}); !reflect.DeepEqual(got, append([]int{-1}, tt.want...)) {
+				t.Errorf("BitVector.AppendIndices() = %v, want %v", got, append([]int{-1}, tt.want...))
+			}
+		})
+	}
+
+	if _, err := bitvector.FromIndices([]int{10}, 10); !errors.Is(err, bitvector.ErrIndexOutOfRange) {
+		t.Errorf("FromIndices() err = %v, want %v", err, bitvector.ErrIndexOutOfRange)
+	}
+}
+
+func TestBitVector_ToIndices_IgnoresBitsPastLength(t *testing.T) {
+	s := bitvector.NewBitVectorOfLength(3, true)
+	if got := s.ToIndices(); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("BitVector.ToIndices() = %v, want %v", got, []int{0, 1, 2})
+	}
+}
+
+func TestFromUints(t *testing.T) {
+	s, err := bitvector.FromUint32s([]uint32{0x80000001, 0xffffffff}, 34)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ToIndices(); !reflect.DeepEqual(got, []int{0, 31, 32, 33}) {
+		t.Errorf("FromUint32s().ToIndices() = %v, want %v", got, []int{0, 31, 32, 33})
+	}
+
+	s, err = bitvector.FromUint64s([]uint64{1<<63 | 1<<32 | 1, 0xff}, 66)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ToIndices(); !reflect.DeepEqual(got, []int{0, 32, 63, 64, 65}) {
+		t.Errorf("FromUint64s().ToIndices() = %v, want %v", got, []int{0, 32, 63, 64, 65})
+	}
+
+	if _, err := bitvector.FromUint64s([]uint64{0}, 65); !errors.Is(err, bitvector.ErrLengthMismatch) {
+		t.Errorf("FromUint64s() err = %v, want %v", err, bitvector.ErrLengthMismatch)
+	}
+}
@@ -0,0 +1,220 @@
+package bitvector
+
+import "fmt"
+
+// BitMatrix is a two dimensional matrix of bits stored row by row in one
+// BitVector, each row padded to a whole number of words so rows can be viewed
+// as BitVectors that share the matrix storage.
+type BitMatrix struct {
+	vector *BitVector
+	rows   int
+	cols   int
+	stride int
+}
+
+// Allocates a BitMatrix of rows by cols bits all set to false.
+func NewBitMatrix(rows, cols int) *BitMatrix {
+	if rows < 0 || cols < 0 {
+		panic(ErrNegativeLength)
+	}
+
+	stride, err := getArrayLength(cols, bitsPerInt32)
+	if err != nil {
+		panic(err)
+	}
+
+	return &BitMatrix{
+		vector: NewBitVector(rows * stride * bitsPerInt32),
+		rows:   rows,
+		cols:   cols,
+		stride: stride,
+	}
+}
+
+// Allocates a BitMatrix with a copy of each vector as a row, all rows must have the same length.
+func NewBitMatrixFromRows(rows []*BitVector) *BitMatrix {
+	cols := 0
+	if len(rows) > 0 {
+		cols = rows[0].Length()
+	}
+
+	s := NewBitMatrix(len(rows), cols)
+	for r, row := range rows {
+		if row.Length() != cols {
+			panic(fmt.Errorf("%w: row %v length is different", ErrLengthMismatch, r))
+		}
+		copy(s.vector.array[r*s.stride:(r+1)*s.stride], row.array)
+		s.Row(r).clearTail()
+	}
+	return s
+}
+
+// Allocates an n by n BitMatrix with true on the diagonal.
+func NewIdentityBitMatrix(n int) *BitMatrix {
+	s := NewBitMatrix(n, n)
+	for i := 0; i < n; i++ {
+		s.Set(i, i, true)
+	}
+	return s
+}
+
+// Rows returns the number of rows.
+func (s *BitMatrix) Rows() int {
+	return s.rows
+}
+
+// Cols returns the number of columns.
+func (s *BitMatrix) Cols() int {
+	return s.cols
+}
+
+// Returns the bit value at row r and column c.
+func (s *BitMatrix) Get(r, c int) bool {
+	return s.vector.Get(s.index(r, c))
+}
+
+// Sets the bit value at row r and column c to value.
+func (s *BitMatrix) Set(r, c int, bit bool) {
+	s.vector.Set(s.index(r, c), bit)
+}
+
+// Row returns row r as a BitVector that shares the matrix storage, changes to
+// either are visible in the other. The view can not be resized in place.
+func (s *BitMatrix) Row(r int) *BitVector {
+	if r < 0 || r >= s.rows {
+		panic(fmt.Errorf("%w: row %v", ErrIndexOutOfRange, r))
+	}
+
+	start := r * s.stride
+	return &BitVector{
+		array:  s.vector.array[start : start+s.stride : start+s.stride],
+		length: s.cols,
+	}
+}
+
+// Column returns a copy of column c.
+func (s *BitMatrix) Column(c int) *BitVector {
+	if c < 0 || c >= s.cols {
+		panic(fmt.Errorf("%w: column %v", ErrIndexOutOfRange, c))
+	}
+
+	column := NewBitVector(s.rows)
+	for r := 0; r < s.rows; r++ {
+		if s.vector.array[r*s.stride+c/bitsPerInt32]&(1<<(c%bitsPerInt32)) != 0 {
+			column.array[r/bitsPerInt32] |= 1 << (r % bitsPerInt32)
+		}
+	}
+	return column
+}
+
+// RowTrueBits returns the number of true bits in row r.
+func (s *BitMatrix) RowTrueBits(r int) int {
+	row := s.Row(r)
+	return onesBefore(row, row.Length())
+}
+
+// ColumnTrueBits returns the number of true bits in column c.
+func (s *BitMatrix) ColumnTrueBits(c int) int {
+	return s.Column(c).TrueBits()
+}
+
+// Transpose returns a new cols by rows matrix with the rows and columns swapped,
+// moving 64 by 64 blocks of bits at a time.
+func (s *BitMatrix) Transpose() *BitMatrix {
+	transposed := NewBitMatrix(s.cols, s.rows)
+
+	var block [64]uint64
+	for rb := 0; rb < s.rows; rb += 64 {
+		height := minInt(64, s.rows-rb)
+		for cb := 0; cb < s.cols; cb += 64 {
+			width := minInt(64, s.cols-cb)
+
+			for i := 0; i < 64; i++ {
+				block[i] = 0
+				if i < height {
+					block[i] = s.vector.getBits((rb+i)*s.stride*bitsPerInt32+cb, width)
+				}
+			}
+
+			transpose64(&block)
+
+			for j := 0; j < width; j++ {
+				transposed.vector.setBits((cb+j)*transposed.stride*bitsPerInt32+rb, height, block[j])
+			}
+		}
+	}
+
+	return transposed
+}
+
+// transpose64 transposes a 64 by 64 block where bit j of block[i] is row i column j,
+// by swapping off diagonal quadrants of halving size.
+func transpose64(block *[64]uint64) {
+	mask := uint64(0x00000000ffffffff)
+	for j := 32; j != 0; {
+		for k := 0; k < 64; k = (k + j + 1) &^ j {
+			t := ((block[k] >> j) ^ block[k+j]) & mask
+			block[k] ^= t << j
+			block[k+j] ^= t
+		}
+		j >>= 1
+		mask ^= mask << j
+	}
+}
+
+// Equal reports whether s and matrix have the same dimensions and bit values.
+func (s *BitMatrix) Equal(matrix *BitMatrix) bool {
+	if matrix == nil {
+		panic(ErrNilVector)
+	}
+	if s.rows != matrix.rows || s.cols != matrix.cols {
+		return false
+	}
+
+	for r := 0; r < s.rows; r++ {
+		if !s.Row(r).Equal(matrix.Row(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ANDed with matrix.
+func (s *BitMatrix) And(matrix *BitMatrix) {
+	s.vector.And(s.operand(matrix).vector)
+}
+
+// ORed with matrix.
+func (s *BitMatrix) Or(matrix *BitMatrix) {
+	s.vector.Or(s.operand(matrix).vector)
+}
+
+// XORed with matrix.
+func (s *BitMatrix) Xor(matrix *BitMatrix) {
+	s.vector.Xor(s.operand(matrix).vector)
+}
+
+func (s *BitMatrix) String() string {
+	str := ""
+	for r := 0; r < s.rows; r++ {
+		str += fmt.Sprintf("%b\n", s.Row(r))
+	}
+	return str
+}
+
+func (s *BitMatrix) operand(matrix *BitMatrix) *BitMatrix {
+	if matrix == nil {
+		panic(ErrNilVector)
+	}
+	if s.rows != matrix.rows || s.cols != matrix.cols {
+		panic(fmt.Errorf("%w: matrix %vx%v is not %vx%v", ErrLengthMismatch, matrix.rows, matrix.cols, s.rows, s.cols))
+	}
+	return matrix
+}
+
+func (s *BitMatrix) index(r, c int) int {
+	if r < 0 || r >= s.rows || c < 0 || c >= s.cols {
+		panic(fmt.Errorf("%w: row %v column %v", ErrIndexOutOfRange, r, c))
+	}
+	return r*s.stride*bitsPerInt32 + c
+}
@@ -0,0 +1,122 @@
+package bitvector_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func randomBitMatrix(r *rand.Rand, rows, cols int) *bitvector.BitMatrix {
+	s := bitvector.NewBitMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			s.Set(i, j, r.Intn(2) == 1)
+		}
+	}
+	return s
+}
+
+func TestBitMatrix_Transpose(t *testing.T) {
+	tests := []struct {
+		name string
+		rows int
+		cols int
+	}{
+		{"square", 64, 64},
+		{"small", 3, 5},
+		{"wide", 10, 200},
+		{"tall", 130, 33},
+		{"empty", 0, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(tt.rows*1000 + tt.cols)))
+			s := randomBitMatrix(r, tt.rows, tt.cols)
+
+			got := s.Transpose()
+			if got.Rows() != tt.cols || got.Cols() != tt.rows {
+				t.Fatalf("BitMatrix.Transpose() = %vx%v, want %vx%v", got.Rows(), got.Cols(), tt.cols, tt.rows)
+			}
+			for i := 0; i < tt.rows; i++ {
+				for j := 0; j < tt.cols; j++ {
+					if got.Get(j, i) != s.Get(i, j) {
+						t.Fatalf("BitMatrix.Transpose().Get(%v, %v) = %v, want %v", j, i, got.Get(j, i), s.Get(i, j))
+					}
+				}
+			}
+
+			if !got.Transpose().Equal(s) {
+				t.Errorf("BitMatrix.Transpose().Transpose() != s")
+			}
+		})
+	}
+}
+
+func TestBitMatrix_Rows_Columns(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := randomBitMatrix(r, 40, 70)
+
+	for i := 0; i < s.Rows(); i++ {
+		row := s.Row(i)
+		count := 0
+		for j := 0; j < s.Cols(); j++ {
+			if row.Get(j) != s.Get(i, j) {
+				t.Fatalf("BitMatrix.Row(%v).Get(%v) = %v, want %v", i, j, row.Get(j), s.Get(i, j))
+			}
+			if s.Get(i, j) {
+				count++
+			}
+		}
+		if got := s.RowTrueBits(i); got != count {
+			t.Errorf("BitMatrix.RowTrueBits(%v) = %v, want %v", i, got, count)
+		}
+	}
+
+	for j := 0; j < s.Cols(); j++ {
+		column := s.Column(j)
+		count := 0
+		for i := 0; i < s.Rows(); i++ {
+			if column.Get(i) != s.Get(i, j) {
+				t.Fatalf("BitMatrix.Column(%v).Get(%v) = %v, want %v", j, i, column.Get(i), s.Get(i, j))
+			}
+			if s.Get(i, j) {
+				count++
+			}
+		}
+		if got := s.ColumnTrueBits(j); got != count {
+			t.Errorf("BitMatrix.ColumnTrueBits(%v) = %v, want %v", j, got, count)
+		}
+	}
+
+	row := s.Row(3)
+	row.Not()
+	if got := s.RowTrueBits(3); got != 70-row.Rank(false, 70) {
+		t.Errorf("BitMatrix.RowTrueBits() after Row().Not() = %v", got)
+	}
+	if s.Get(4, 0) != s.Row(4).Get(0) {
+		t.Errorf("BitMatrix.Row().Not() changed the next row")
+	}
+}
+
+func TestBitMatrix_Operations(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	left := randomBitMatrix(r, 5, 37)
+	right := randomBitMatrix(r, 5, 37)
+
+	and := bitvector.NewBitMatrixFromRows([]*bitvector.BitVector{left.Row(0), left.Row(1), left.Row(2), left.Row(3), left.Row(4)})
+	or := bitvector.NewBitMatrixFromRows([]*bitvector.BitVector{left.Row(0), left.Row(1), left.Row(2), left.Row(3), left.Row(4)})
+	xor := bitvector.NewBitMatrixFromRows([]*bitvector.BitVector{left.Row(0), left.Row(1), left.Row(2), left.Row(3), left.Row(4)})
+	and.And(right)
+	or.Or(right)
+	xor.Xor(right)
+
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 37; j++ {
+			a, b := left.Get(i, j), right.Get(i, j)
+			if and.Get(i, j) != (a && b) || or.Get(i, j) != (a || b) || xor.Get(i, j) != (a != b) {
+				t.Fatalf("BitMatrix operations at %v, %v wrong", i, j)
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// PackedArray stores fixed width unsigned integers back to back in a BitVector,
+// values may straddle word boundaries so no bits are wasted.
+type PackedArray struct {
+	vector *BitVector
+	width  int
+	length int
+}
+
+// Allocates a PackedArray of length values each width bits wide, width must be between 1 and 64.
+func NewPackedArray(width, length int) *PackedArray {
+	if width < 1 || width > 64 {
+		panic(fmt.Errorf("%w: width %v must be between 1 and 64", ErrIndexOutOfRange, width))
+	}
+	if length < 0 {
+		panic(ErrNegativeLength)
+	}
+
+	return &PackedArray{
+		vector: NewBitVector(width * length),
+		width:  width,
+		length: length,
+	}
+}
+
+// Allocates a PackedArray holding values, the width is the fewest bits that fit the largest value.
+func NewPackedArrayFromValues(values []uint64) *PackedArray {
+	max := uint64(0)
+	for _, value := range values {
+		if value > max {
+			max = value
+		}
+	}
+
+	width := bits.Len64(max)
+	if width == 0 {
+		width = 1
+	}
+
+	s := NewPackedArray(width, len(values))
+	for i, value := range values {
+		s.vector.setBits(i*width, width, value)
+	}
+	return s
+}
+
+// Width returns the number of bits per value.
+func (s *PackedArray) Width() int {
+	return s.width
+}
+
+// Length returns the number of values.
+func (s *PackedArray) Length() int {
+	return s.length
+}
+
+// BitVector returns the bits backing the array.
+func (s *PackedArray) BitVector() *BitVector {
+	return s.vector
+}
+
+// Returns the value at position index.
+func (s *PackedArray) Get(index int) uint64 {
+	if index < 0 || index >= s.length {
+		panic(fmt.Errorf("%w: index %v", ErrIndexOutOfRange, index))
+	}
+
+	return s.vector.getBits(index*s.width, s.width)
+}
+
+// Sets the value at position index, value must fit in the width.
+func (s *PackedArray) Set(index int, value uint64) {
+	if index < 0 || index >= s.length {
+		panic(fmt.Errorf("%w: index %v", ErrIndexOutOfRange, index))
+	}
+	s.checkValue(value)
+
+	s.vector.setBits(index*s.width, s.width, value)
+	s.vector.version++
+}
+
+// Append adds value to the end of the array, value must fit in the width.
+func (s *PackedArray) Append(value uint64) {
+	s.checkValue(value)
+
+	s.vector.AppendBits(value, s.width)
+	s.length++
+}
+
+// Unpack appends every value to dst and returns the extended slice.
+func (s *PackedArray) Unpack(dst []uint64) []uint64 {
+	for i := 0; i < s.length; i++ {
+		dst = append(dst, s.vector.getBits(i*s.width, s.width))
+	}
+	return dst
+}
+
+func (s *PackedArray) checkValue(value uint64) {
+	if bits.Len64(value) > s.width {
+		panic(fmt.Errorf("%w: value %v does not fit in %v bits", ErrIndexOutOfRange, value, s.width))
+	}
+}
@@ -0,0 +1,107 @@
+package bitvector_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestPackedArray_Get_Set(t *testing.T) {
+	tests := []struct {
+		name   string
+		width  int
+		length int
+	}{
+		{
+			name:   "width 1",
+			width:  1,
+			length: 100,
+		},
+		{
+			name:   "width 5",
+			width:  5,
+			length: 100,
+		},
+		{
+			name:   "width 17",
+			width:  17,
+			length: 100,
+		},
+		{
+			name:   "width 32",
+			width:  32,
+			length: 10,
+		},
+		{
+			name:   "width 63",
+			width:  63,
+			length: 10,
+		},
+		{
+			name:   "width 64",
+			width:  64,
+			length: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(tt.width)))
+			want := make([]uint64, tt.length)
+			s := bitvector.NewPackedArray(tt.width, tt.length)
+			for i := range want {
+				want[i] = r.Uint64() >> (64 - tt.width)
+				s.Set(i, want[i])
+			}
+
+			for i := range want {
+				if got := s.Get(i); got != want[i] {
+					t.Fatalf("PackedArray.Get(%v) = %v, want %v", i, got, want[i])
+				}
+			}
+
+			if got := s.Unpack(nil); !reflect.DeepEqual(got, want) {
+				t.Errorf("PackedArray.Unpack() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPackedArray_Append(t *testing.T) {
+	s := bitvector.NewPackedArray(13, 0)
+	want := []uint64{}
+	for i := 0; i < 1000; i++ {
+		value := uint64(i*7) % (1 << 13)
+		s.Append(value)
+		want = append(want, value)
+	}
+
+	if s.Length() != len(want) {
+		t.Errorf("PackedArray.Length() = %v, want %v", s.Length(), len(want))
+	}
+	if got := s.Unpack(nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("PackedArray.Unpack() = %v, want %v", got, want)
+	}
+}
+
+func TestNewPackedArrayFromValues(t *testing.T) {
+	values := []uint64{3, 0, 17, 100000, 5}
+	s := bitvector.NewPackedArrayFromValues(values)
+	if s.Width() != 17 {
+		t.Errorf("PackedArray.Width() = %v, want %v", s.Width(), 17)
+	}
+	if got := s.Unpack(nil); !reflect.DeepEqual(got, values) {
+		t.Errorf("PackedArray.Unpack() = %v, want %v", got, values)
+	}
+}
+
+func TestPackedArray_SetOverflow(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("PackedArray.Set() did not panic")
+		}
+	}()
+
+	bitvector.NewPackedArray(4, 1).Set(0, 16)
+}
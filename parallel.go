@@ -0,0 +1,158 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/bits"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Number of uint32 words in a 64 byte cache line, chunks are a multiple of this
+// so no two workers write to the same cache line.
+const wordsPerCacheLine = 16
+
+// Below this many words the parallel functions fall back to the serial loop.
+const parallelThreshold = 1 << 14
+
+// parallelWords splits the first n words into cache aligned chunks and runs fn
+// over each chunk on its own goroutine, one per runtime.GOMAXPROCS.
+func parallelWords(n int, fn func(start, end int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if n < parallelThreshold || workers < 2 {
+		fn(0, n)
+		return
+	}
+
+	chunk, err := getArrayLength(n, workers)
+	if err != nil {
+		panic(err)
+	}
+	chunk = ((chunk + wordsPerCacheLine - 1) / wordsPerCacheLine) * wordsPerCacheLine
+
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			fn(start, end)
+		}(start, end)
+	}
+	wg.Wait()
+}
+
+// ParallelTrueBits is TrueBits split across worker goroutines.
+func (s *BitVector) ParallelTrueBits() int {
+	arrayLength, err := getArrayLength(s.length, bitsPerInt32)
+	if err != nil {
+		panic(err)
+	}
+
+	var output int64
+	parallelWords(arrayLength, func(start, end int) {
+		count := 0
+		for i := start; i < end; i++ {
+			count += bits.OnesCount32(s.array[i])
+		}
+		atomic.AddInt64(&output, int64(count))
+	})
+
+	return int(output)
+}
+
+// ParallelRank is Rank split across worker goroutines.
+func (s *BitVector) ParallelRank(bit bool, offset int) int {
+	if offset < 0 || offset > s.Length() {
+		panic(fmt.Errorf("%w: offset %v", ErrIndexOutOfRange, offset))
+	}
+
+	words := offset / bitsPerInt32
+
+	var output int64
+	parallelWords(words, func(start, end int) {
+		count := 0
+		for i := start; i < end; i++ {
+			count += bits.OnesCount32(s.array[i])
+		}
+		atomic.AddInt64(&output, int64(count))
+	})
+
+	rest := offset % bitsPerInt32
+	if rest > 0 {
+		output += int64(bits.OnesCount32(s.array[words] & ((1 << rest) - 1)))
+	}
+
+	if bit {
+		return int(output)
+	}
+	return offset - int(output)
+}
+
+// ParallelAnd is And split across worker goroutines.
+func (s *BitVector) ParallelAnd(vector *BitVector) {
+	arrayLength := s.parallelOperand(vector)
+
+	parallelWords(arrayLength, func(start, end int) {
+		for i := start; i < end; i++ {
+			s.array[i] &= vector.array[i]
+		}
+	})
+
+	s.version++
+}
+
+// ParallelOr is Or split across worker goroutines.
+func (s *BitVector) ParallelOr(vector *BitVector) {
+	arrayLength := s.parallelOperand(vector)
+
+	parallelWords(arrayLength, func(start, end int) {
+		for i := start; i < end; i++ {
+			s.array[i] |= vector.array[i]
+		}
+	})
+
+	s.version++
+}
+
+// ParallelXor is Xor split across worker goroutines.
+func (s *BitVector) ParallelXor(vector *BitVector) {
+	arrayLength := s.parallelOperand(vector)
+
+	parallelWords(arrayLength, func(start, end int) {
+		for i := start; i < end; i++ {
+			s.array[i] ^= vector.array[i]
+		}
+	})
+
+	s.version++
+}
+
+// ParallelNot is Not split across worker goroutines.
+func (s *BitVector) ParallelNot() {
+	arrayLength, err := getArrayLength(s.length, bitsPerInt32)
+	if err != nil {
+		panic(err)
+	}
+
+	parallelWords(arrayLength, func(start, end int) {
+		for i := start; i < end; i++ {
+			s.array[i] = ^s.array[i]
+		}
+	})
+
+	s.version++
+}
+
+func (s *BitVector) parallelOperand(vector *BitVector) int {
+	arrayLength, err := s.operand(vector)
+	if err != nil {
+		panic(err)
+	}
+
+	return arrayLength
+}
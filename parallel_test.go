@@ -0,0 +1,86 @@
+package bitvector_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func randomBitVector(r *rand.Rand, length int) *bitvector.BitVector {
+	values := make([]bool, length)
+	for i := range values {
+		values[i] = r.Intn(2) == 1
+	}
+	return bitvector.NewBitVectorFromBool(values)
+}
+
+func equalBitVector(t *testing.T, name string, got, want *bitvector.BitVector) {
+	t.Helper()
+	if got.Length() != want.Length() {
+		t.Fatalf("%v Length() = %v, want %v", name, got.Length(), want.Length())
+	}
+	for i := 0; i < want.Length(); i++ {
+		if got.Get(i) != want.Get(i) {
+			t.Fatalf("%v Get(%v) = %v, want %v", name, i, got.Get(i), want.Get(i))
+		}
+	}
+}
+
+func TestBitVector_Parallel(t *testing.T) {
+	tests := []struct {
+		name   string
+		length int
+	}{
+		{
+			name:   "serial",
+			length: 1000,
+		},
+		{
+			name:   "parallel",
+			length: (1 << 14) * 32 * 3,
+		},
+		{
+			name:   "parallel uneven",
+			length: (1<<14)*32*3 + 17,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(tt.length)))
+			left := randomBitVector(r, tt.length)
+			right := randomBitVector(r, tt.length)
+
+			if got, want := left.ParallelTrueBits(), left.TrueBits(); got != want {
+				t.Errorf("BitVector.ParallelTrueBits() = %v, want %v", got, want)
+			}
+
+			for _, offset := range []int{0, 1, tt.length / 3, tt.length} {
+				if got, want := left.ParallelRank(true, offset), left.Rank(true, offset); got != want {
+					t.Errorf("BitVector.ParallelRank(true, %v) = %v, want %v", offset, got, want)
+				}
+				if got, want := left.ParallelRank(false, offset), left.Rank(false, offset); got != want {
+					t.Errorf("BitVector.ParallelRank(false, %v) = %v, want %v", offset, got, want)
+				}
+			}
+
+			ops := []struct {
+				name     string
+				serial   func(a, b *bitvector.BitVector)
+				parallel func(a, b *bitvector.BitVector)
+			}{
+				{"And", (*bitvector.BitVector).And, (*bitvector.BitVector).ParallelAnd},
+				{"Or", (*bitvector.BitVector).Or, (*bitvector.BitVector).ParallelOr},
+				{"Xor", (*bitvector.BitVector).Xor, (*bitvector.BitVector).ParallelXor},
+				{"Not", func(a, _ *bitvector.BitVector) { a.Not() }, func(a, _ *bitvector.BitVector) { a.ParallelNot() }},
+			}
+			for _, op := range ops {
+				want := bitvector.NewBitVectorFromVector(*left)
+				got := bitvector.NewBitVectorFromVector(*left)
+				op.serial(want, right)
+				op.parallel(got, right)
+				equalBitVector(t, op.name, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package bitvector
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Polynomial is a polynomial over GF(2), coefficient i is bit i of a BitVector.
+// Addition and subtraction are both XOR.
+type Polynomial struct {
+	vector *BitVector
+}
+
+// Allocates a Polynomial with the coefficients from vector, bit i is the coefficient of x^i.
+func NewPolynomial(vector *BitVector) *Polynomial {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+
+	s := &Polynomial{
+		vector: NewBitVectorFromVector(*vector),
+	}
+	s.normalize()
+	return s
+}
+
+// Allocates a Polynomial with the coefficients from the bits of value, bit i is the coefficient of x^i.
+func NewPolynomialFromUint64(value uint64) *Polynomial {
+	vector := NewBitVector(0)
+	vector.AppendBits(value, 64)
+	return NewPolynomial(vector)
+}
+
+// Allocates a Polynomial with a coefficient of one for each of the exponents.
+func NewPolynomialFromExponents(exponents ...int) *Polynomial {
+	degree := -1
+	for _, exponent := range exponents {
+		if exponent < 0 {
+			panic(fmt.Errorf("%w: exponent %v", ErrIndexOutOfRange, exponent))
+		}
+		degree = maxInt(degree, exponent)
+	}
+
+	vector := NewBitVector(degree + 1)
+	for _, exponent := range exponents {
+		vector.Set(exponent, true)
+	}
+	return NewPolynomial(vector)
+}
+
+// BitVector returns a copy of the coefficients.
+func (s *Polynomial) BitVector() *BitVector {
+	return NewBitVectorFromVector(*s.vector)
+}
+
+// Degree returns the highest exponent with a coefficient of one, or -1 for the zero polynomial.
+func (s *Polynomial) Degree() int {
+	return s.vector.Length() - 1
+}
+
+// IsZero reports whether every coefficient is zero.
+func (s *Polynomial) IsZero() bool {
+	return s.vector.Length() == 0
+}
+
+// Coefficient returns the coefficient of x^i.
+func (s *Polynomial) Coefficient(i int) bool {
+	if i < 0 {
+		panic(fmt.Errorf("%w: exponent %v", ErrIndexOutOfRange, i))
+	}
+	if i >= s.vector.Length() {
+		return false
+	}
+	return s.vector.Get(i)
+}
+
+// Equal reports whether s and p have the same coefficients.
+func (s *Polynomial) Equal(p *Polynomial) bool {
+	return s.vector.Equal(p.vector)
+}
+
+// Add returns s + p, which over GF(2) is also s - p.
+func (s *Polynomial) Add(p *Polynomial) *Polynomial {
+	words := make([]uint32, maxInt(len(s.vector.array), len(p.vector.array)))
+	xorShifted(words, s.vector, 0)
+	xorShifted(words, p.vector, 0)
+	return newPolynomialFromWords(words)
+}
+
+// Mul returns s * p.
+func (s *Polynomial) Mul(p *Polynomial) *Polynomial {
+	if s.IsZero() || p.IsZero() {
+		return NewPolynomial(NewBitVector(0))
+	}
+
+	words := make([]uint32, (s.Degree()+p.Degree())/bitsPerInt32+1)
+	for i, word := range s.vector.array {
+		for word != 0 {
+			xorShifted(words, p.vector, i*bitsPerInt32+bits.TrailingZeros32(word))
+			word &= word - 1
+		}
+	}
+	return newPolynomialFromWords(words)
+}
+
+// DivMod returns the quotient and remainder of s divided by p.
+func (s *Polynomial) DivMod(p *Polynomial) (*Polynomial, *Polynomial, error) {
+	if p.IsZero() {
+		return nil, nil, ErrDivisionByZero
+	}
+
+	remainder := make([]uint32, len(s.vector.array))
+	copy(remainder, s.vector.array)
+
+	quotient := make([]uint32, len(s.vector.array))
+	degree := s.Degree()
+	for degree >= p.Degree() {
+		shift := degree - p.Degree()
+		quotient[shift/bitsPerInt32] |= 1 << (shift % bitsPerInt32)
+		xorShifted(remainder, p.vector, shift)
+		degree = wordsDegree(remainder, degree)
+	}
+
+	return newPolynomialFromWords(quotient), newPolynomialFromWords(remainder), nil
+}
+
+// Mod returns the remainder of s divided by p.
+func (s *Polynomial) Mod(p *Polynomial) *Polynomial {
+	_, remainder, err := s.DivMod(p)
+	if err != nil {
+		panic(err)
+	}
+	return remainder
+}
+
+// GCD returns the greatest common divisor of a and b.
+func GCD(a, b *Polynomial) *Polynomial {
+	for !b.IsZero() {
+		a, b = b, a.Mod(b)
+	}
+	return a
+}
+
+// IsIrreducible reports whether s has no factors other than 1 and itself, using
+// Ben-Or's test: s of degree n is irreducible when gcd(s, x^(2^i) - x) = 1 for
+// every i up to n/2.
+func (s *Polynomial) IsIrreducible() bool {
+	degree := s.Degree()
+	if degree < 1 {
+		return false
+	}
+
+	x := NewPolynomialFromExponents(1)
+	power := x
+	for i := 1; i <= degree/2; i++ {
+		power = power.Mul(power).Mod(s)
+		if GCD(s, power.Add(x)).Degree() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Polynomial) String() string {
+	if s.IsZero() {
+		return "0"
+	}
+
+	terms := []string{}
+	for i := s.Degree(); i >= 0; i-- {
+		if !s.vector.Get(i) {
+			continue
+		}
+		switch i {
+		case 0:
+			terms = append(terms, "1")
+		case 1:
+			terms = append(terms, "x")
+		default:
+			terms = append(terms, fmt.Sprintf("x^%v", i))
+		}
+	}
+	return strings.Join(terms, " + ")
+}
+
+// normalize trims the coefficients to the degree so the top bit is always one
+// and clears the bits past it, which the word level operations rely on.
+func (s *Polynomial) normalize() {
+	s.vector.Resize(s.vector.Length() - s.vector.LeadingZeros())
+	s.vector.clearTail()
+}
+
+func newPolynomialFromWords(words []uint32) *Polynomial {
+	vector := NewBitVector(0)
+	vector.array = words
+	vector.length = wordsDegree(words, len(words)*bitsPerInt32-1) + 1
+	vector.array = vector.array[:vector.words()]
+	return &Polynomial{
+		vector: vector,
+	}
+}
+
+// wordsDegree returns the highest set bit of words at or below degree, or -1.
+func wordsDegree(words []uint32, degree int) int {
+	if degree < 0 {
+		return -1
+	}
+
+	for i := degree / bitsPerInt32; i >= 0; i-- {
+		word := words[i]
+		if i == degree/bitsPerInt32 && degree%bitsPerInt32 < bitsPerInt32-1 {
+			word &= 1<<(degree%bitsPerInt32+1) - 1
+		}
+		if word != 0 {
+			return i*bitsPerInt32 + bits.Len32(word) - 1
+		}
+	}
+	return -1
+}
+
+// xorShifted XORs the bits of vector shifted up by shift into words, which must be long enough.
+func xorShifted(words []uint32, vector *BitVector, shift int) {
+	offset := shift / bitsPerInt32
+	rest := uint(shift % bitsPerInt32)
+	arrayLength := vector.words()
+	for i := 0; i < arrayLength; i++ {
+		word := vector.maskedWord(i)
+		words[offset+i] ^= word << rest
+		if rest > 0 && word>>(bitsPerInt32-rest) != 0 {
+			words[offset+i+1] ^= word >> (bitsPerInt32 - rest)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package bitvector_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func randomPolynomial(r *rand.Rand, degree int) *bitvector.Polynomial {
+	exponents := []int{degree}
+	for i := 0; i < degree; i++ {
+		if r.Intn(2) == 1 {
+			exponents = append(exponents, i)
+		}
+	}
+	return bitvector.NewPolynomialFromExponents(exponents...)
+}
+
+func TestPolynomial_Degree(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *bitvector.Polynomial
+		want int
+	}{
+		{name: "zero", p: bitvector.NewPolynomialFromUint64(0), want: -1},
+		{name: "one", p: bitvector.NewPolynomialFromUint64(1), want: 0},
+		{name: "x^8", p: bitvector.NewPolynomialFromUint64(0x11b), want: 8},
+		{name: "x^100", p: bitvector.NewPolynomialFromExponents(100, 3), want: 100},
+		{name: "repeated exponent", p: bitvector.NewPolynomialFromExponents(1, 1), want: 1},
+		{name: "trailing zeros", p: bitvector.NewPolynomial(bitvector.NewBitVectorFromBool([]bool{true, true, false, false})), want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Degree(); got != tt.want {
+				t.Errorf("Polynomial.Degree() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolynomial_String(t *testing.T) {
+	tests := []struct {
+		p    *bitvector.Polynomial
+		want string
+	}{
+		{p: bitvector.NewPolynomialFromUint64(0), want: "0"},
+		{p: bitvector.NewPolynomialFromUint64(1), want: "1"},
+		{p: bitvector.NewPolynomialFromUint64(0x11b), want: "x^8 + x^4 + x^3 + x + 1"},
+	}
+	for _, tt := range tests {
+		if got := tt.p.String(); got != tt.want {
+			t.Errorf("Polynomial.String() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestPolynomial_Mul(t *testing.T) {
+	// (x + 1)^2 = x^2 + 1
+	a := bitvector.NewPolynomialFromUint64(0b11)
+	if got := a.Mul(a); !got.Equal(bitvector.NewPolynomialFromUint64(0b101)) {
+		t.Errorf("Polynomial.Mul() = %v, want x^2 + 1", got)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		x, y := r.Uint64()>>32, r.Uint64()>>32
+		want := uint64(0)
+		for j := 0; j < 32; j++ {
+			if y>>j&1 == 1 {
+				want ^= x << j
+			}
+		}
+
+		got := bitvector.NewPolynomialFromUint64(x).Mul(bitvector.NewPolynomialFromUint64(y))
+		if !got.Equal(bitvector.NewPolynomialFromUint64(want)) {
+			t.Fatalf("Polynomial.Mul(%x, %x) = %v, want %x", x, y, got, want)
+		}
+	}
+}
+
+func TestPolynomial_DivMod(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 200; i++ {
+		a := randomPolynomial(r, r.Intn(200))
+		b := randomPolynomial(r, r.Intn(100))
+
+		q, m, err := a.DivMod(b)
+		if err != nil {
+			t.Fatalf("Polynomial.DivMod() error = %v", err)
+		}
+		if m.Degree() >= b.Degree() {
+			t.Fatalf("Polynomial.DivMod() remainder degree %v, divisor degree %v", m.Degree(), b.Degree())
+		}
+		if got := q.Mul(b).Add(m); !got.Equal(a) {
+			t.Fatalf("q*b + r = %v, want %v", got, a)
+		}
+	}
+
+	_, _, err := bitvector.NewPolynomialFromUint64(5).DivMod(bitvector.NewPolynomialFromUint64(0))
+	if !errors.Is(err, bitvector.ErrDivisionByZero) {
+		t.Errorf("Polynomial.DivMod() error = %v, want %v", err, bitvector.ErrDivisionByZero)
+	}
+}
+
+func TestPolynomial_Mod_Panics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Polynomial.Mod() by zero did not panic")
+		}
+	}()
+	bitvector.NewPolynomialFromUint64(5).Mod(bitvector.NewPolynomialFromUint64(0))
+}
+
+func TestGCD(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 50; i++ {
+		common := randomPolynomial(r, 1+r.Intn(20))
+		a := randomPolynomial(r, r.Intn(40)).Mul(common)
+		b := randomPolynomial(r, r.Intn(40)).Mul(common)
+
+		g := bitvector.GCD(a, b)
+		if !a.Mod(g).IsZero() || !b.Mod(g).IsZero() {
+			t.Fatalf("GCD(%v, %v) = %v does not divide both", a, b, g)
+		}
+		if !g.Mod(common).IsZero() {
+			t.Fatalf("GCD(%v, %v) = %v is not a multiple of %v", a, b, g, common)
+		}
+	}
+
+	if got := bitvector.GCD(bitvector.NewPolynomialFromUint64(0b111), bitvector.NewPolynomialFromUint64(0b11)); !got.Equal(bitvector.NewPolynomialFromUint64(1)) {
+		t.Errorf("GCD() = %v, want 1", got)
+	}
+}
+
+func TestPolynomial_IsIrreducible(t *testing.T) {
+	tests := []struct {
+		name string
+		p    uint64
+		want bool
+	}{
+		{name: "x", p: 0b10, want: true},
+		{name: "x + 1", p: 0b11, want: true},
+		{name: "x^2 + x + 1", p: 0b111, want: true},
+		{name: "x^2 + 1", p: 0b101, want: false},
+		{name: "x^3 + x + 1", p: 0b1011, want: true},
+		{name: "AES", p: 0x11b, want: true},
+		{name: "x^8 + 1", p: 0x101, want: false},
+		{name: "CRC-16", p: 0x18005, want: false},
+		{name: "x^15 + x + 1", p: 0x8003, want: true},
+		{name: "x^32 + x^7 + x^3 + x^2 + 1", p: 0x10000008d, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bitvector.NewPolynomialFromUint64(tt.p).IsIrreducible(); got != tt.want {
+				t.Errorf("Polynomial.IsIrreducible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Count the irreducible polynomials of degree 8, there are 30.
+	count := 0
+	for p := uint64(0x100); p < 0x200; p++ {
+		if bitvector.NewPolynomialFromUint64(p).IsIrreducible() {
+			count++
+		}
+	}
+	if count != 30 {
+		t.Errorf("irreducible polynomials of degree 8 = %v, want 30", count)
+	}
+}
@@ -0,0 +1,347 @@
+package bitvector
+
+import "sync"
+
+// SyncBitVector wraps a BitVector with a read/write lock so it can be shared
+// between goroutines. Reads take the read lock and writes take the write lock,
+// so multi-bit operations such as And are atomic as a whole.
+type SyncBitVector struct {
+	mu     sync.RWMutex
+	vector *BitVector
+}
+
+// Allocates a SyncBitVector guarding vector. The caller must not use vector directly afterwards.
+func NewSyncBitVector(vector *BitVector) *SyncBitVector {
+	if vector == nil {
+		panic(ErrNilVector)
+	}
+
+	return &SyncBitVector{
+		vector: vector,
+	}
+}
+
+// Returns the bit value at position index.
+func (s *SyncBitVector) Get(index int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.Get(index)
+}
+
+// Returns the bit value at position index, or ErrIndexOutOfRange.
+func (s *SyncBitVector) TryGet(index int) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.TryGet(index)
+}
+
+// Sets the bit value at position index to value.
+func (s *SyncBitVector) Set(index int, bit bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.Set(index, bit)
+}
+
+// Sets the bit value at position index to value, or returns ErrIndexOutOfRange.
+func (s *SyncBitVector) TrySet(index int, bit bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vector.TrySet(index, bit)
+}
+
+// Sets all the bit values to value.
+func (s *SyncBitVector) SetAll(bit bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.SetAll(bit)
+}
+
+// Copies the bits between indexStart and indexEnd into vector.
+func (s *SyncBitVector) Copy(vector *BitVector, indexStart, indexEnd int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.vector.Copy(vector, indexStart, indexEnd)
+}
+
+// Copies the bits between indexStart and indexEnd into vector, or returns an error for bad bounds.
+func (s *SyncBitVector) TryCopy(vector *BitVector, indexStart, indexEnd int) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.TryCopy(vector, indexStart, indexEnd)
+}
+
+func (s *SyncBitVector) Length() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.Length()
+}
+
+func (s *SyncBitVector) Resize(length int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.Resize(length)
+}
+
+// TryResize is Resize returning ErrNegativeLength instead of panicking.
+func (s *SyncBitVector) TryResize(length int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vector.TryResize(length)
+}
+
+// Cap returns the number of bits the bitvector can hold before it has to reallocate.
+func (s *SyncBitVector) Cap() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.Cap()
+}
+
+// Grow increases the capacity, if necessary, so another n bits can be appended without reallocating.
+func (s *SyncBitVector) Grow(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.Grow(n)
+}
+
+// Shrink releases any capacity beyond the length.
+func (s *SyncBitVector) Shrink() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.Shrink()
+}
+
+// Append adds bit to the end of the bitvector.
+func (s *SyncBitVector) Append(bit bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.Append(bit)
+}
+
+// AppendBits adds the low width bits of value to the end of the bitvector, least significant bit first.
+func (s *SyncBitVector) AppendBits(value uint64, width int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.AppendBits(value, width)
+}
+
+// AppendVector adds the bits of vector to the end of the bitvector.
+func (s *SyncBitVector) AppendVector(vector *BitVector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.AppendVector(vector)
+}
+
+// Pop removes and returns the last bit.
+func (s *SyncBitVector) Pop() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vector.Pop()
+}
+
+// Returns the width bit field starting at offset as an unsigned integer.
+func (s *SyncBitVector) GetBits(offset, width int) uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.GetBits(offset, width)
+}
+
+// Returns the width bit field starting at offset, or ErrIndexOutOfRange.
+func (s *SyncBitVector) TryGetBits(offset, width int) (uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.TryGetBits(offset, width)
+}
+
+// Returns the width bit field starting at offset as a sign extended signed integer.
+func (s *SyncBitVector) GetSignedBits(offset, width int) int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.GetSignedBits(offset, width)
+}
+
+// Returns the width bit field starting at offset as a signed integer, or ErrIndexOutOfRange.
+func (s *SyncBitVector) TryGetSignedBits(offset, width int) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.TryGetSignedBits(offset, width)
+}
+
+// Sets the width bit field starting at offset to value.
+func (s *SyncBitVector) SetBits(offset, width int, value uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.SetBits(offset, width, value)
+}
+
+// Sets the width bit field starting at offset to value, or returns ErrIndexOutOfRange.
+func (s *SyncBitVector) TrySetBits(offset, width int, value uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vector.TrySetBits(offset, width, value)
+}
+
+// Sets the width bit field starting at offset to the two's complement of value.
+func (s *SyncBitVector) SetSignedBits(offset, width int, value int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.SetSignedBits(offset, width, value)
+}
+
+// Sets the width bit field starting at offset to the two's complement of value, or returns ErrIndexOutOfRange.
+func (s *SyncBitVector) TrySetSignedBits(offset, width int, value int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vector.TrySetSignedBits(offset, width, value)
+}
+
+// Rank counts the number of true or false (depending on what the bit is set to)
+// in the bitvector but not including the offset
+func (s *SyncBitVector) Rank(bit bool, offset int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.Rank(bit, offset)
+}
+
+// TryRank is Rank returning ErrIndexOutOfRange instead of panicking.
+func (s *SyncBitVector) TryRank(bit bool, offset int) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.TryRank(bit, offset)
+}
+
+// find the offset of true or false (depending on what the bit is set to) from the rank
+// (number of times the bit occurs)
+func (s *SyncBitVector) Select(bit bool, rank int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.Select(bit, rank)
+}
+
+func (s *SyncBitVector) Concat(vectors []*BitVector) *BitVector {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.Concat(vectors)
+}
+
+func (s *SyncBitVector) TrueBits() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.TrueBits()
+}
+
+// ANDed with vector.
+func (s *SyncBitVector) And(vector *BitVector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.And(vector)
+}
+
+// ANDed with vector, or returns ErrNilVector or ErrLengthMismatch.
+func (s *SyncBitVector) TryAnd(vector *BitVector) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vector.TryAnd(vector)
+}
+
+// ORed with vector.
+func (s *SyncBitVector) Or(vector *BitVector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.Or(vector)
+}
+
+// ORed with vector, or returns ErrNilVector or ErrLengthMismatch.
+func (s *SyncBitVector) TryOr(vector *BitVector) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vector.TryOr(vector)
+}
+
+// XORed with vector.
+func (s *SyncBitVector) Xor(vector *BitVector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.Xor(vector)
+}
+
+// XORed with vector, or returns ErrNilVector or ErrLengthMismatch.
+func (s *SyncBitVector) TryXor(vector *BitVector) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vector.TryXor(vector)
+}
+
+// Inverts all the bit values. On/true bit values are converted to off/false. Off/false bit values are turned on/true.
+func (s *SyncBitVector) Not() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vector.Not()
+}
+
+func (s *SyncBitVector) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.vector.String()
+}
+
+// Snapshot returns a copy of the current bit values that is safe to use without the lock.
+func (s *SyncBitVector) Snapshot() *BitVector {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return NewBitVectorFromVector(*s.vector)
+}
+
+// Enumerate iterates over a snapshot of the bit values, so writers committing
+// while the iterator is in use do not cause it to fail.
+func (s *SyncBitVector) Enumerate() *BitVectorIterator {
+	return s.Snapshot().Enumerate()
+}
+
+// EnumerateFromOffset iterates over a snapshot of the bit values between indexStart and indexEnd.
+func (s *SyncBitVector) EnumerateFromOffset(indexStart, indexEnd int) *BitVectorIterator {
+	return s.Snapshot().EnumerateFromOffset(indexStart, indexEnd)
+}
+
+// TryEnumerateFromOffset is EnumerateFromOffset returning ErrIndexOutOfRange instead of panicking.
+func (s *SyncBitVector) TryEnumerateFromOffset(indexStart, indexEnd int) (*BitVectorIterator, error) {
+	return s.Snapshot().TryEnumerateFromOffset(indexStart, indexEnd)
+}
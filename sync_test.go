@@ -0,0 +1,261 @@
+package bitvector_test
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/rossmerr/bitvector"
+)
+
+func TestSyncBitVector_Get_Set(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []bool
+	}{
+		{
+			name:   "Get Set",
+			values: []bool{true, false, true, true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := bitvector.NewSyncBitVector(bitvector.NewBitVector(len(tt.values)))
+			for i := 0; i < len(tt.values); i++ {
+				s.Set(i, tt.values[i])
+			}
+
+			for i := 0; i < len(tt.values); i++ {
+				if got := s.Get(i); got != tt.values[i] {
+					t.Errorf("SyncBitVector.Get() = %v, want %v", got, tt.values[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSyncBitVector_Concurrent(t *testing.T) {
+	length := 256
+	s := bitvector.NewSyncBitVector(bitvector.NewBitVector(length))
+	mask := bitvector.NewBitVectorOfLength(length, true)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < length; i += 4 {
+				s.Set(i, true)
+				s.And(mask)
+			}
+		}(w)
+	}
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				iterator := s.Enumerate()
+				for iterator.HasNext() {
+					iterator.Next()
+				}
+				s.Rank(true, length)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := s.Rank(true, length); got != length {
+		t.Errorf("SyncBitVector.Rank() = %v, want %v", got, length)
+	}
+}
+
+func TestSyncBitVector_Enumerate(t *testing.T) {
+	values := []bool{true, false, true, true, true}
+	s := bitvector.NewSyncBitVector(bitvector.NewBitVectorFromBool(values))
+
+	iterator := s.Enumerate()
+	s.Set(1, true)
+
+	counter := 0
+	for iterator.HasNext() {
+		value, i := iterator.Next()
+		if value != values[i] {
+			t.Errorf("BitVectorIterator.Next() = %v, want %v", value, values[i])
+		}
+		counter++
+	}
+
+	if counter != len(values) {
+		t.Errorf("counter = %v, want %v", counter, len(values))
+	}
+}
+
+func TestSyncBitVector_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(s *bitvector.SyncBitVector) error
+		want error
+	}{
+		{
+			name: "TryGet",
+			fn: func(s *bitvector.SyncBitVector) error {
+				_, err := s.TryGet(4)
+				return err
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TryCopy",
+			fn: func(s *bitvector.SyncBitVector) error {
+				return s.TryCopy(bitvector.NewBitVector(4), 0, 5)
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TryResize",
+			fn: func(s *bitvector.SyncBitVector) error {
+				return s.TryResize(-1)
+			},
+			want: bitvector.ErrNegativeLength,
+		},
+		{
+			name: "TryRank",
+			fn: func(s *bitvector.SyncBitVector) error {
+				_, err := s.TryRank(true, 5)
+				return err
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TryEnumerateFromOffset",
+			fn: func(s *bitvector.SyncBitVector) error {
+				_, err := s.TryEnumerateFromOffset(2, 5)
+				return err
+			},
+			want: bitvector.ErrIndexOutOfRange,
+		},
+		{
+			name: "TryEnumerateFromOffset in range",
+			fn: func(s *bitvector.SyncBitVector) error {
+				iterator, err := s.TryEnumerateFromOffset(1, 3)
+				if err != nil {
+					return err
+				}
+				s.SetAll(true)
+				_, _, err = iterator.TryNext()
+				return err
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fn(bitvector.NewSyncBitVector(bitvector.NewBitVector(4)))
+			if tt.want == nil && err != nil {
+				t.Errorf("err = %v, want nil", err)
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncBitVector_Append_Pop(t *testing.T) {
+	s := bitvector.NewSyncBitVector(bitvector.NewBitVector(0))
+	s.Grow(64)
+	if s.Cap() < 64 {
+		t.Errorf("SyncBitVector.Cap() = %v, want >= %v", s.Cap(), 64)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				s.Append(true)
+				s.AppendBits(0b10, 2)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Length() != 8*1000*3 {
+		t.Fatalf("SyncBitVector.Length() = %v, want %v", s.Length(), 8*1000*3)
+	}
+	if s.TrueBits() != 8*1000*2 {
+		t.Errorf("SyncBitVector.TrueBits() = %v, want %v", s.TrueBits(), 8*1000*2)
+	}
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				s.Pop()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Length() != 8*1000*2 {
+		t.Errorf("SyncBitVector.Length() = %v, want %v", s.Length(), 8*1000*2)
+	}
+
+	s.AppendVector(bitvector.NewBitVectorFromBool([]bool{true, false, true}))
+	s.Shrink()
+	if s.Length() != 8*1000*2+3 || s.Cap() < s.Length() {
+		t.Errorf("SyncBitVector.Length(), Cap() = %v, %v", s.Length(), s.Cap())
+	}
+}
+
+func TestSyncBitVector_Bits(t *testing.T) {
+	s := bitvector.NewSyncBitVector(bitvector.NewBitVector(128))
+
+	// Writers store either all zeros or all ones across three words, readers
+	// must never see a mix of the two.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if (i+j)%2 == 0 {
+					s.SetBits(20, 64, math.MaxUint64)
+				} else {
+					s.SetSignedBits(20, 64, 0)
+				}
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if got := s.GetBits(20, 64); got != 0 && got != math.MaxUint64 {
+					t.Errorf("SyncBitVector.GetBits() = %x, want all zeros or all ones", got)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := s.TrySetSignedBits(100, 8, -5); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.TryGetSignedBits(100, 8); err != nil || got != -5 {
+		t.Errorf("SyncBitVector.TryGetSignedBits() = %v, %v, want %v", got, err, -5)
+	}
+	if got := s.GetSignedBits(100, 8); got != -5 {
+		t.Errorf("SyncBitVector.GetSignedBits() = %v, want %v", got, -5)
+	}
+	if err := s.TrySetBits(120, 9, 0); !errors.Is(err, bitvector.ErrIndexOutOfRange) {
+		t.Errorf("SyncBitVector.TrySetBits() err = %v, want %v", err, bitvector.ErrIndexOutOfRange)
+	}
+	if _, err := s.TryGetBits(120, 9); !errors.Is(err, bitvector.ErrIndexOutOfRange) {
+		t.Errorf("SyncBitVector.TryGetBits() err = %v, want %v", err, bitvector.ErrIndexOutOfRange)
+	}
+}